@@ -19,12 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Quizzes_CreateQuiz_FullMethodName = "/quiz.v1.Quizzes/CreateQuiz"
-	Quizzes_GetQuiz_FullMethodName    = "/quiz.v1.Quizzes/GetQuiz"
-	Quizzes_ListQuiz_FullMethodName   = "/quiz.v1.Quizzes/ListQuiz"
-	Quizzes_UpdateQuiz_FullMethodName = "/quiz.v1.Quizzes/UpdateQuiz"
-	Quizzes_DeleteQuiz_FullMethodName = "/quiz.v1.Quizzes/DeleteQuiz"
-	Quizzes_SearchQuiz_FullMethodName = "/quiz.v1.Quizzes/SearchQuiz"
+	Quizzes_CreateQuiz_FullMethodName  = "/quiz.v1.Quizzes/CreateQuiz"
+	Quizzes_GetQuiz_FullMethodName     = "/quiz.v1.Quizzes/GetQuiz"
+	Quizzes_ListQuiz_FullMethodName    = "/quiz.v1.Quizzes/ListQuiz"
+	Quizzes_UpdateQuiz_FullMethodName  = "/quiz.v1.Quizzes/UpdateQuiz"
+	Quizzes_DeleteQuiz_FullMethodName  = "/quiz.v1.Quizzes/DeleteQuiz"
+	Quizzes_PublishQuiz_FullMethodName = "/quiz.v1.Quizzes/PublishQuiz"
+	Quizzes_SearchQuiz_FullMethodName  = "/quiz.v1.Quizzes/SearchQuiz"
+	Quizzes_CloneQuiz_FullMethodName   = "/quiz.v1.Quizzes/CloneQuiz"
 )
 
 // QuizzesClient is the client API for Quizzes service.
@@ -36,7 +38,12 @@ type QuizzesClient interface {
 	ListQuiz(ctx context.Context, in *ListQuizRequest, opts ...grpc.CallOption) (*ListQuizResponse, error)
 	UpdateQuiz(ctx context.Context, in *UpdateQuizRequest, opts ...grpc.CallOption) (*UpdateQuizResponse, error)
 	DeleteQuiz(ctx context.Context, in *DeleteQuizRequest, opts ...grpc.CallOption) (*DeleteQuizResponse, error)
+	// PublishQuiz announces a finished quiz to other services. Only its author can publish it.
+	PublishQuiz(ctx context.Context, in *PublishQuizRequest, opts ...grpc.CallOption) (*PublishQuizResponse, error)
 	SearchQuiz(ctx context.Context, in *SearchQuizRequest, opts ...grpc.CallOption) (*SearchQuizResponse, error)
+	// CloneQuiz copies a quiz and every question it owns into a new draft. The copy
+	// records its source under the "cloned_from" metadata key.
+	CloneQuiz(ctx context.Context, in *CloneQuizRequest, opts ...grpc.CallOption) (*CloneQuizResponse, error)
 }
 
 type quizzesClient struct {
@@ -97,6 +104,16 @@ func (c *quizzesClient) DeleteQuiz(ctx context.Context, in *DeleteQuizRequest, o
 	return out, nil
 }
 
+func (c *quizzesClient) PublishQuiz(ctx context.Context, in *PublishQuizRequest, opts ...grpc.CallOption) (*PublishQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishQuizResponse)
+	err := c.cc.Invoke(ctx, Quizzes_PublishQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *quizzesClient) SearchQuiz(ctx context.Context, in *SearchQuizRequest, opts ...grpc.CallOption) (*SearchQuizResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchQuizResponse)
@@ -107,6 +124,16 @@ func (c *quizzesClient) SearchQuiz(ctx context.Context, in *SearchQuizRequest, o
 	return out, nil
 }
 
+func (c *quizzesClient) CloneQuiz(ctx context.Context, in *CloneQuizRequest, opts ...grpc.CallOption) (*CloneQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneQuizResponse)
+	err := c.cc.Invoke(ctx, Quizzes_CloneQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuizzesServer is the server API for Quizzes service.
 // All implementations must embed UnimplementedQuizzesServer
 // for forward compatibility.
@@ -116,7 +143,12 @@ type QuizzesServer interface {
 	ListQuiz(context.Context, *ListQuizRequest) (*ListQuizResponse, error)
 	UpdateQuiz(context.Context, *UpdateQuizRequest) (*UpdateQuizResponse, error)
 	DeleteQuiz(context.Context, *DeleteQuizRequest) (*DeleteQuizResponse, error)
+	// PublishQuiz announces a finished quiz to other services. Only its author can publish it.
+	PublishQuiz(context.Context, *PublishQuizRequest) (*PublishQuizResponse, error)
 	SearchQuiz(context.Context, *SearchQuizRequest) (*SearchQuizResponse, error)
+	// CloneQuiz copies a quiz and every question it owns into a new draft. The copy
+	// records its source under the "cloned_from" metadata key.
+	CloneQuiz(context.Context, *CloneQuizRequest) (*CloneQuizResponse, error)
 	mustEmbedUnimplementedQuizzesServer()
 }
 
@@ -142,9 +174,15 @@ func (UnimplementedQuizzesServer) UpdateQuiz(context.Context, *UpdateQuizRequest
 func (UnimplementedQuizzesServer) DeleteQuiz(context.Context, *DeleteQuizRequest) (*DeleteQuizResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuiz not implemented")
 }
+func (UnimplementedQuizzesServer) PublishQuiz(context.Context, *PublishQuizRequest) (*PublishQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishQuiz not implemented")
+}
 func (UnimplementedQuizzesServer) SearchQuiz(context.Context, *SearchQuizRequest) (*SearchQuizResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchQuiz not implemented")
 }
+func (UnimplementedQuizzesServer) CloneQuiz(context.Context, *CloneQuizRequest) (*CloneQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneQuiz not implemented")
+}
 func (UnimplementedQuizzesServer) mustEmbedUnimplementedQuizzesServer() {}
 func (UnimplementedQuizzesServer) testEmbeddedByValue()                 {}
 
@@ -256,6 +294,24 @@ func _Quizzes_DeleteQuiz_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Quizzes_PublishQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizzesServer).PublishQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quizzes_PublishQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizzesServer).PublishQuiz(ctx, req.(*PublishQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Quizzes_SearchQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchQuizRequest)
 	if err := dec(in); err != nil {
@@ -274,6 +330,24 @@ func _Quizzes_SearchQuiz_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Quizzes_CloneQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizzesServer).CloneQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quizzes_CloneQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizzesServer).CloneQuiz(ctx, req.(*CloneQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Quizzes_ServiceDesc is the grpc.ServiceDesc for Quizzes service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -301,10 +375,18 @@ var Quizzes_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteQuiz",
 			Handler:    _Quizzes_DeleteQuiz_Handler,
 		},
+		{
+			MethodName: "PublishQuiz",
+			Handler:    _Quizzes_PublishQuiz_Handler,
+		},
 		{
 			MethodName: "SearchQuiz",
 			Handler:    _Quizzes_SearchQuiz_Handler,
 		},
+		{
+			MethodName: "CloneQuiz",
+			Handler:    _Quizzes_CloneQuiz_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "quizzes/v1/quizzes.proto",
@@ -312,6 +394,7 @@ var Quizzes_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	Questions_CreateQuestion_FullMethodName          = "/quiz.v1.Questions/CreateQuestion"
+	Questions_ListBankQuestions_FullMethodName       = "/quiz.v1.Questions/ListBankQuestions"
 	Questions_GetQuestion_FullMethodName             = "/quiz.v1.Questions/GetQuestion"
 	Questions_ListQuestion_FullMethodName            = "/quiz.v1.Questions/ListQuestion"
 	Questions_UpdateQuestion_FullMethodName          = "/quiz.v1.Questions/UpdateQuestion"
@@ -330,6 +413,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QuestionsClient interface {
 	CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...grpc.CallOption) (*CreateQuestionResponse, error)
+	ListBankQuestions(ctx context.Context, in *ListBankQuestionsRequest, opts ...grpc.CallOption) (*ListBankQuestionsResponse, error)
 	GetQuestion(ctx context.Context, in *GetQuestionRequest, opts ...grpc.CallOption) (*GetQuestionResponse, error)
 	ListQuestion(ctx context.Context, in *ListQuestionRequest, opts ...grpc.CallOption) (*ListQuestionResponse, error)
 	UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest, opts ...grpc.CallOption) (*UpdateQuestionResponse, error)
@@ -361,6 +445,16 @@ func (c *questionsClient) CreateQuestion(ctx context.Context, in *CreateQuestion
 	return out, nil
 }
 
+func (c *questionsClient) ListBankQuestions(ctx context.Context, in *ListBankQuestionsRequest, opts ...grpc.CallOption) (*ListBankQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBankQuestionsResponse)
+	err := c.cc.Invoke(ctx, Questions_ListBankQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *questionsClient) GetQuestion(ctx context.Context, in *GetQuestionRequest, opts ...grpc.CallOption) (*GetQuestionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetQuestionResponse)
@@ -476,6 +570,7 @@ func (c *questionsClient) ReorderAnswers(ctx context.Context, in *ReorderAnswers
 // for forward compatibility.
 type QuestionsServer interface {
 	CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error)
+	ListBankQuestions(context.Context, *ListBankQuestionsRequest) (*ListBankQuestionsResponse, error)
 	GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error)
 	ListQuestion(context.Context, *ListQuestionRequest) (*ListQuestionResponse, error)
 	UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error)
@@ -500,6 +595,9 @@ type UnimplementedQuestionsServer struct{}
 func (UnimplementedQuestionsServer) CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestion not implemented")
 }
+func (UnimplementedQuestionsServer) ListBankQuestions(context.Context, *ListBankQuestionsRequest) (*ListBankQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBankQuestions not implemented")
+}
 func (UnimplementedQuestionsServer) GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQuestion not implemented")
 }
@@ -572,6 +670,24 @@ func _Questions_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Questions_ListBankQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBankQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionsServer).ListBankQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Questions_ListBankQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionsServer).ListBankQuestions(ctx, req.(*ListBankQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Questions_GetQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetQuestionRequest)
 	if err := dec(in); err != nil {
@@ -781,6 +897,10 @@ var Questions_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateQuestion",
 			Handler:    _Questions_CreateQuestion_Handler,
 		},
+		{
+			MethodName: "ListBankQuestions",
+			Handler:    _Questions_ListBankQuestions_Handler,
+		},
 		{
 			MethodName: "GetQuestion",
 			Handler:    _Questions_GetQuestion_Handler,
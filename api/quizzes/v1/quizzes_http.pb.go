@@ -19,18 +19,25 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationQuizzesCloneQuiz = "/quiz.v1.Quizzes/CloneQuiz"
 const OperationQuizzesCreateQuiz = "/quiz.v1.Quizzes/CreateQuiz"
 const OperationQuizzesDeleteQuiz = "/quiz.v1.Quizzes/DeleteQuiz"
 const OperationQuizzesGetQuiz = "/quiz.v1.Quizzes/GetQuiz"
 const OperationQuizzesListQuiz = "/quiz.v1.Quizzes/ListQuiz"
+const OperationQuizzesPublishQuiz = "/quiz.v1.Quizzes/PublishQuiz"
 const OperationQuizzesSearchQuiz = "/quiz.v1.Quizzes/SearchQuiz"
 const OperationQuizzesUpdateQuiz = "/quiz.v1.Quizzes/UpdateQuiz"
 
 type QuizzesHTTPServer interface {
+	// CloneQuiz copies a quiz and every question it owns into a new draft. The copy
+	// records its source under the "cloned_from" metadata key.
+	CloneQuiz(context.Context, *CloneQuizRequest) (*CloneQuizResponse, error)
 	CreateQuiz(context.Context, *CreateQuizRequest) (*CreateQuizResponse, error)
 	DeleteQuiz(context.Context, *DeleteQuizRequest) (*DeleteQuizResponse, error)
 	GetQuiz(context.Context, *GetQuizRequest) (*GetQuizResponse, error)
 	ListQuiz(context.Context, *ListQuizRequest) (*ListQuizResponse, error)
+	// PublishQuiz announces a finished quiz to other services. Only its author can publish it.
+	PublishQuiz(context.Context, *PublishQuizRequest) (*PublishQuizResponse, error)
 	SearchQuiz(context.Context, *SearchQuizRequest) (*SearchQuizResponse, error)
 	UpdateQuiz(context.Context, *UpdateQuizRequest) (*UpdateQuizResponse, error)
 }
@@ -42,7 +49,9 @@ func RegisterQuizzesHTTPServer(s *http.Server, srv QuizzesHTTPServer) {
 	r.GET("/quizzes", _Quizzes_ListQuiz0_HTTP_Handler(srv))
 	r.PATCH("/quizzes/{id}", _Quizzes_UpdateQuiz0_HTTP_Handler(srv))
 	r.DELETE("/quizzes/{id}", _Quizzes_DeleteQuiz0_HTTP_Handler(srv))
+	r.POST("/quizzes/{id}/publish", _Quizzes_PublishQuiz0_HTTP_Handler(srv))
 	r.GET("/quizzes/search", _Quizzes_SearchQuiz0_HTTP_Handler(srv))
+	r.POST("/quizzes/{id}/clone", _Quizzes_CloneQuiz0_HTTP_Handler(srv))
 }
 
 func _Quizzes_CreateQuiz0_HTTP_Handler(srv QuizzesHTTPServer) func(ctx http.Context) error {
@@ -155,6 +164,31 @@ func _Quizzes_DeleteQuiz0_HTTP_Handler(srv QuizzesHTTPServer) func(ctx http.Cont
 	}
 }
 
+func _Quizzes_PublishQuiz0_HTTP_Handler(srv QuizzesHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PublishQuizRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationQuizzesPublishQuiz)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PublishQuiz(ctx, req.(*PublishQuizRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PublishQuizResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Quizzes_SearchQuiz0_HTTP_Handler(srv QuizzesHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SearchQuizRequest
@@ -174,11 +208,38 @@ func _Quizzes_SearchQuiz0_HTTP_Handler(srv QuizzesHTTPServer) func(ctx http.Cont
 	}
 }
 
+func _Quizzes_CloneQuiz0_HTTP_Handler(srv QuizzesHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CloneQuizRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationQuizzesCloneQuiz)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CloneQuiz(ctx, req.(*CloneQuizRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CloneQuizResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type QuizzesHTTPClient interface {
+	CloneQuiz(ctx context.Context, req *CloneQuizRequest, opts ...http.CallOption) (rsp *CloneQuizResponse, err error)
 	CreateQuiz(ctx context.Context, req *CreateQuizRequest, opts ...http.CallOption) (rsp *CreateQuizResponse, err error)
 	DeleteQuiz(ctx context.Context, req *DeleteQuizRequest, opts ...http.CallOption) (rsp *DeleteQuizResponse, err error)
 	GetQuiz(ctx context.Context, req *GetQuizRequest, opts ...http.CallOption) (rsp *GetQuizResponse, err error)
 	ListQuiz(ctx context.Context, req *ListQuizRequest, opts ...http.CallOption) (rsp *ListQuizResponse, err error)
+	PublishQuiz(ctx context.Context, req *PublishQuizRequest, opts ...http.CallOption) (rsp *PublishQuizResponse, err error)
 	SearchQuiz(ctx context.Context, req *SearchQuizRequest, opts ...http.CallOption) (rsp *SearchQuizResponse, err error)
 	UpdateQuiz(ctx context.Context, req *UpdateQuizRequest, opts ...http.CallOption) (rsp *UpdateQuizResponse, err error)
 }
@@ -191,6 +252,19 @@ func NewQuizzesHTTPClient(client *http.Client) QuizzesHTTPClient {
 	return &QuizzesHTTPClientImpl{client}
 }
 
+func (c *QuizzesHTTPClientImpl) CloneQuiz(ctx context.Context, in *CloneQuizRequest, opts ...http.CallOption) (*CloneQuizResponse, error) {
+	var out CloneQuizResponse
+	pattern := "/quizzes/{id}/clone"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationQuizzesCloneQuiz))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *QuizzesHTTPClientImpl) CreateQuiz(ctx context.Context, in *CreateQuizRequest, opts ...http.CallOption) (*CreateQuizResponse, error) {
 	var out CreateQuizResponse
 	pattern := "/quizzes"
@@ -243,6 +317,19 @@ func (c *QuizzesHTTPClientImpl) ListQuiz(ctx context.Context, in *ListQuizReques
 	return &out, nil
 }
 
+func (c *QuizzesHTTPClientImpl) PublishQuiz(ctx context.Context, in *PublishQuizRequest, opts ...http.CallOption) (*PublishQuizResponse, error) {
+	var out PublishQuizResponse
+	pattern := "/quizzes/{id}/publish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationQuizzesPublishQuiz))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *QuizzesHTTPClientImpl) SearchQuiz(ctx context.Context, in *SearchQuizRequest, opts ...http.CallOption) (*SearchQuizResponse, error) {
 	var out SearchQuizResponse
 	pattern := "/quizzes/search"
@@ -274,6 +361,7 @@ const OperationQuestionsCreateQuestion = "/quiz.v1.Questions/CreateQuestion"
 const OperationQuestionsDeleteAnswer = "/quiz.v1.Questions/DeleteAnswer"
 const OperationQuestionsDeleteQuestion = "/quiz.v1.Questions/DeleteQuestion"
 const OperationQuestionsGetQuestion = "/quiz.v1.Questions/GetQuestion"
+const OperationQuestionsListBankQuestions = "/quiz.v1.Questions/ListBankQuestions"
 const OperationQuestionsListQuestion = "/quiz.v1.Questions/ListQuestion"
 const OperationQuestionsOverrideAnswer = "/quiz.v1.Questions/OverrideAnswer"
 const OperationQuestionsPutAnswers = "/quiz.v1.Questions/PutAnswers"
@@ -288,6 +376,7 @@ type QuestionsHTTPServer interface {
 	DeleteAnswer(context.Context, *DeleteAnswerRequest) (*DeleteAnswerResponse, error)
 	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error)
 	GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error)
+	ListBankQuestions(context.Context, *ListBankQuestionsRequest) (*ListBankQuestionsResponse, error)
 	ListQuestion(context.Context, *ListQuestionRequest) (*ListQuestionResponse, error)
 	OverrideAnswer(context.Context, *OverrideAnswerRequest) (*OverrideAnswerResponse, error)
 	PutAnswers(context.Context, *PutAnswersRequest) (*PutAnswersResponse, error)
@@ -300,6 +389,8 @@ type QuestionsHTTPServer interface {
 func RegisterQuestionsHTTPServer(s *http.Server, srv QuestionsHTTPServer) {
 	r := s.Route("/")
 	r.POST("/quizzes/{quiz_id}/questions", _Questions_CreateQuestion0_HTTP_Handler(srv))
+	r.POST("/questions", _Questions_CreateQuestion1_HTTP_Handler(srv))
+	r.GET("/questions", _Questions_ListBankQuestions0_HTTP_Handler(srv))
 	r.GET("/quizzes/{quiz_id}/questions/{question_id}", _Questions_GetQuestion0_HTTP_Handler(srv))
 	r.GET("/quizzes/{quiz_id}/questions", _Questions_ListQuestion0_HTTP_Handler(srv))
 	r.PATCH("/quizzes/{quiz_id}/questions/{question_id}", _Questions_UpdateQuestion0_HTTP_Handler(srv))
@@ -338,6 +429,47 @@ func _Questions_CreateQuestion0_HTTP_Handler(srv QuestionsHTTPServer) func(ctx h
 	}
 }
 
+func _Questions_CreateQuestion1_HTTP_Handler(srv QuestionsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateQuestionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationQuestionsCreateQuestion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateQuestion(ctx, req.(*CreateQuestionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateQuestionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Questions_ListBankQuestions0_HTTP_Handler(srv QuestionsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBankQuestionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationQuestionsListBankQuestions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBankQuestions(ctx, req.(*ListBankQuestionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBankQuestionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Questions_GetQuestion0_HTTP_Handler(srv QuestionsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetQuestionRequest
@@ -607,6 +739,7 @@ type QuestionsHTTPClient interface {
 	DeleteAnswer(ctx context.Context, req *DeleteAnswerRequest, opts ...http.CallOption) (rsp *DeleteAnswerResponse, err error)
 	DeleteQuestion(ctx context.Context, req *DeleteQuestionRequest, opts ...http.CallOption) (rsp *DeleteQuestionResponse, err error)
 	GetQuestion(ctx context.Context, req *GetQuestionRequest, opts ...http.CallOption) (rsp *GetQuestionResponse, err error)
+	ListBankQuestions(ctx context.Context, req *ListBankQuestionsRequest, opts ...http.CallOption) (rsp *ListBankQuestionsResponse, err error)
 	ListQuestion(ctx context.Context, req *ListQuestionRequest, opts ...http.CallOption) (rsp *ListQuestionResponse, err error)
 	OverrideAnswer(ctx context.Context, req *OverrideAnswerRequest, opts ...http.CallOption) (rsp *OverrideAnswerResponse, err error)
 	PutAnswers(ctx context.Context, req *PutAnswersRequest, opts ...http.CallOption) (rsp *PutAnswersResponse, err error)
@@ -639,7 +772,7 @@ func (c *QuestionsHTTPClientImpl) AddAnswer(ctx context.Context, in *AddAnswerRe
 
 func (c *QuestionsHTTPClientImpl) CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...http.CallOption) (*CreateQuestionResponse, error) {
 	var out CreateQuestionResponse
-	pattern := "/quizzes/{quiz_id}/questions"
+	pattern := "/questions"
 	path := binding.EncodeURL(pattern, in, false)
 	opts = append(opts, http.Operation(OperationQuestionsCreateQuestion))
 	opts = append(opts, http.PathTemplate(pattern))
@@ -689,6 +822,19 @@ func (c *QuestionsHTTPClientImpl) GetQuestion(ctx context.Context, in *GetQuesti
 	return &out, nil
 }
 
+func (c *QuestionsHTTPClientImpl) ListBankQuestions(ctx context.Context, in *ListBankQuestionsRequest, opts ...http.CallOption) (*ListBankQuestionsResponse, error) {
+	var out ListBankQuestionsResponse
+	pattern := "/questions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationQuestionsListBankQuestions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *QuestionsHTTPClientImpl) ListQuestion(ctx context.Context, in *ListQuestionRequest, opts ...http.CallOption) (*ListQuestionResponse, error) {
 	var out ListQuestionResponse
 	pattern := "/quizzes/{quiz_id}/questions"
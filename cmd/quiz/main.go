@@ -8,6 +8,8 @@ import (
 
 	"quiz/internal/conf"
 	"quiz/internal/dep"
+	"quiz/internal/server"
+	"quiz/internal/service"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -35,16 +37,20 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "./configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, outbox *server.OutboxServer, webhooks *server.WebhookServer, health *service.HealthService) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
 		kratos.Version(Version),
 		kratos.Metadata(map[string]string{}),
 		kratos.Logger(logger),
+		// stop reporting ready as soon as shutdown begins
+		kratos.BeforeStop(health.Shutdown),
 		kratos.Server(
 			gs,
 			hs,
+			outbox,
+			webhooks,
 		),
 	)
 }
@@ -27,47 +27,142 @@ import (
 // wireApp init kratos application.
 func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
 	textMapPropagator := dep.NewTextMapPropagator()
-	tracerProvider, err := dep.NewTracerProvider(contextContext, bootstrap, textMapPropagator)
+	tracerProvider, cleanup, err := dep.NewTracerProvider(contextContext, bootstrap, textMapPropagator, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	dataData, cleanup, err := data.NewData(confData, logger, tracerProvider)
+	dataData, cleanup2, err := data.NewData(confData, logger, tracerProvider)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	tracer, err := dep.NewTracer(bootstrap, tracerProvider)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	quizRepo := data.NewQuizRepo(dataData, logger, tracer)
-	quizUsecase := biz.NewQuizUsecase(quizRepo, logger, tracer)
-	quizzesService := service.NewQuizzesService(quizUsecase, logger, tracer)
-	questionsRepo := data.NewQuestionsRepo(dataData, logger, tracer)
-	questionsUsecase := biz.NewQuestionUsecase(questionsRepo, logger, tracer)
-	questionsService := service.NewQuestionsService(questionsUsecase, logger, tracer)
 	meterProvider, err := dep.NewMeterProvider(bootstrap)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	meter, err := dep.NewMeter(bootstrap, meterProvider)
 	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	bizQuizRepo, err := data.NewCachedQuizRepo(dataData, quizRepo, meter, logger, tracer)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	questionsRepo := data.NewQuestionsRepo(dataData, logger, tracer)
+	bizQuestionsRepo, err := data.NewCachedQuestionsRepo(dataData, questionsRepo, meter, logger, tracer)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	collaboratorsRepo := data.NewCollaboratorsRepo(dataData, logger, tracer)
+	productsRepo := data.NewProductsRepo(dataData)
+	entitlementsRepo := data.NewEntitlementsRepo(dataData, logger, tracer)
+	admins := biz.NewAdmins(bootstrap)
+	entitlementsUsecase := biz.NewEntitlementsUsecase(bootstrap, entitlementsRepo, productsRepo, admins, logger, tracer)
+	premiumGate := biz.NewPremiumGate(productsRepo, entitlementsUsecase, collaboratorsRepo, logger, tracer)
+	transaction, err := data.NewTransaction(confData, dataData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	outboxRepo := data.NewOutboxRepo(dataData, logger, tracer)
+	auditRepo := data.NewAuditRepo(dataData, logger, tracer)
+	quizUsecase := biz.NewQuizUsecase(bizQuizRepo, bizQuestionsRepo, collaboratorsRepo, premiumGate, transaction, outboxRepo, auditRepo, logger, tracer)
+	quizzesService := service.NewQuizzesService(quizUsecase, logger, tracer)
+	mediaRepo := data.NewMediaRepo(dataData, logger, tracer)
+	rateLimitStore, err := data.NewRateLimitStore(bootstrap, dataData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	rateLimiter := biz.NewRateLimiter(bootstrap, rateLimitStore, logger)
+	questionsUsecase := biz.NewQuestionUsecase(bizQuestionsRepo, bizQuizRepo, collaboratorsRepo, premiumGate, mediaRepo, rateLimiter, admins, transaction, auditRepo, logger, tracer)
+	questionsService := service.NewQuestionsService(questionsUsecase, logger, tracer)
+	productsUsecase := biz.NewProductsUsecase(productsRepo, logger)
+	productsService := service.NewProductsService(productsUsecase, logger, tracer)
+	entitlementsService := service.NewEntitlementsService(entitlementsUsecase, logger, tracer)
+	attemptsRepo := data.NewAttemptsRepo(dataData, logger, tracer)
+	assignmentsRepo := data.NewAssignmentsRepo(dataData, logger, tracer)
+	groupsRepo := data.NewGroupsRepo(dataData, logger, tracer)
+	assignmentsUsecase := biz.NewAssignmentsUsecase(assignmentsRepo, groupsRepo, bizQuizRepo, collaboratorsRepo, attemptsRepo, logger, tracer)
+	leaderboardRepo := data.NewLeaderboardRepo(dataData, logger, tracer)
+	leaderboardUsecase := biz.NewLeaderboardUsecase(leaderboardRepo, attemptsRepo, bizQuizRepo, admins, rateLimiter, logger, tracer)
+	attemptsUsecase := biz.NewAttemptsUsecase(attemptsRepo, bizQuizRepo, bizQuestionsRepo, premiumGate, assignmentsUsecase, leaderboardUsecase, admins, rateLimiter, transaction, outboxRepo, logger, tracer)
+	attemptsService := service.NewAttemptsService(attemptsUsecase, logger, tracer)
+	resultsRepo := data.NewResultsRepo(dataData, logger, tracer)
+	resultsUsecase := biz.NewResultsUsecase(resultsRepo, bizQuizRepo, bizQuestionsRepo, logger, tracer)
+	resultsService := service.NewResultsService(resultsUsecase, logger, tracer)
+	leaderboardsService := service.NewLeaderboardsService(leaderboardUsecase, logger, tracer)
+	roomBroker := data.NewRoomBroker(dataData, logger)
+	roomsUsecase := biz.NewRoomsUsecase(roomBroker, bizQuizRepo, attemptsUsecase, premiumGate, logger, tracer)
+	roomsService := service.NewRoomsService(roomsUsecase, logger, tracer)
+	liveQuizService := service.NewLiveQuizService(roomsUsecase, logger, tracer)
+	webhooksRepo := data.NewWebhooksRepo(dataData, logger, tracer)
+	webhookDeliveriesRepo := data.NewWebhookDeliveriesRepo(dataData, logger, tracer)
+	webhookSender := data.NewWebhookSender()
+	webhooksUsecase := biz.NewWebhooksUsecase(bootstrap, webhooksRepo, webhookDeliveriesRepo, webhookSender, bizQuizRepo, logger, tracer)
+	webhooksService := service.NewWebhooksService(webhooksUsecase, logger, tracer)
+	auditUsecase := biz.NewAuditUsecase(auditRepo, logger, tracer)
+	auditLogService := service.NewAuditLogService(auditUsecase, logger, tracer)
+	collaboratorsUsecase := biz.NewCollaboratorsUsecase(collaboratorsRepo, bizQuizRepo, logger, tracer)
+	collaboratorsService := service.NewCollaboratorsService(collaboratorsUsecase, logger, tracer)
+	groupsUsecase := biz.NewGroupsUsecase(groupsRepo, assignmentsRepo, transaction, logger, tracer)
+	groupsService := service.NewGroupsService(groupsUsecase, logger, tracer)
+	assignmentsService := service.NewAssignmentsService(assignmentsUsecase, logger, tracer)
+	translationsUsecase := biz.NewTranslationsUsecase(bizQuizRepo, bizQuestionsRepo, collaboratorsRepo, transaction, auditRepo, logger, tracer)
+	translationsService := service.NewTranslationsService(translationsUsecase, logger, tracer)
+	blobStore, err := data.NewBlobStore(bootstrap, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	mediaUsecase := biz.NewMediaUsecase(bootstrap, mediaRepo, blobStore, logger, tracer)
+	mediaService := service.NewMediaService(mediaUsecase, logger, tracer)
+	healthRepo := data.NewHealthRepo(dataData)
+	healthUsecase := biz.NewHealthUsecase(healthRepo, logger, tracer)
+	healthService := service.NewHealthService(healthUsecase, logger, tracer)
+	grpcServer, err := server.NewGRPCServer(confServer, quizzesService, questionsService, productsService, entitlementsService, attemptsService, resultsService, leaderboardsService, roomsService, liveQuizService, webhooksService, auditLogService, collaboratorsService, groupsService, assignmentsService, translationsService, mediaService, healthService, rateLimiter, logger, meter, tracerProvider)
+	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	grpcServer, err := server.NewGRPCServer(confServer, quizzesService, questionsService, logger, meter, tracerProvider)
+	httpServer, err := server.NewHTTPServer(confServer, quizzesService, questionsService, productsService, entitlementsService, attemptsService, resultsService, leaderboardsService, roomsService, webhooksService, auditLogService, collaboratorsService, groupsService, assignmentsService, translationsService, mediaService, healthService, rateLimiter, logger, meter, tracerProvider)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	httpServer, err := server.NewHTTPServer(confServer, quizzesService, questionsService, logger, meter, tracerProvider)
+	eventSink, cleanup3, err := data.NewEventSink(bootstrap, logger)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	app := newApp(logger, grpcServer, httpServer)
+	eventRelay := biz.NewEventRelay(bootstrap, outboxRepo, eventSink, webhooksUsecase, logger, tracer)
+	outboxServer := server.NewOutboxServer(eventRelay)
+	webhookServer := server.NewWebhookServer(webhooksUsecase)
+	app := newApp(logger, grpcServer, httpServer, outboxServer, webhookServer, healthService)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
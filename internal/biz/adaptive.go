@@ -0,0 +1,212 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+// startAdaptive turns the drawn questions of attempt into the pool of an
+// adaptive attempt and asks the first question at the start difficulty.
+func (u *AttemptsUsecase) startAdaptive(ctx context.Context, attempt *Attempt, settings *AdaptiveSettings) error {
+	attempt.Adaptive = true
+	attempt.Pool = attempt.QuestionIDs
+	attempt.QuestionIDs = nil
+	attempt.Length = settings.Length
+	if n := uint32(len(attempt.Pool)); attempt.Length > n {
+		attempt.Length = n
+	}
+	if settings.StartDifficulty != nil {
+		attempt.Level = clampDifficulty(int(*settings.StartDifficulty))
+	}
+
+	pool, err := u.questions.ListByIDs(ctx, attempt.Pool)
+	if err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	next := pickAdaptive(attempt, pool)
+	if next == nil {
+		return pb.ErrorInvalidArgument("quiz has no questions")
+	}
+	attempt.QuestionIDs = []string{next.ID}
+	return nil
+}
+
+// AnswerQuestion grades the current question of an adaptive attempt, moves the
+// target difficulty one step and asks the next question. The returned question
+// is nil once the attempt has reached its length or run out of questions.
+func (u *AttemptsUsecase) AnswerQuestion(ctx context.Context, id string, userID string, response AttemptResponse) (*AttemptResponse, *Question, *Attempt, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.AttemptsUsecase.AnswerQuestion")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id), attribute.String("question_id", response.QuestionID))
+
+	attempt, err := u.getOwn(ctx, id, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !attempt.Adaptive {
+		return nil, nil, nil, pb.ErrorInvalidArgument("submit the attempt as a whole")
+	}
+	if attempt.SubmittedAt != nil {
+		return nil, nil, nil, pb.ErrorAttemptAlreadySubmitted("attempt already submitted")
+	}
+	answered := len(attempt.Responses)
+	current := attempt.QuestionIDs[len(attempt.QuestionIDs)-1]
+	if response.QuestionID != current || answered != len(attempt.QuestionIDs)-1 {
+		return nil, nil, nil, pb.ErrorInvalidArgument("question %s is not the current question", response.QuestionID)
+	}
+	// the graded response gives the answer key away like a validation does
+	if err := u.limiter.AllowValidation(ctx, current); err != nil {
+		return nil, nil, nil, err
+	}
+
+	pool, err := u.questions.ListByIDs(ctx, attempt.Pool)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, nil, nil, err
+	}
+	byID := make(map[string]*Question, len(pool))
+	for _, q := range pool {
+		byID[q.ID] = q
+	}
+	question, ok := byID[current]
+	if !ok {
+		return nil, nil, nil, pb.ErrorQuestionNotFound("question %s was deleted", current)
+	}
+
+	response.Score, response.Correct = gradeResponse(question, response.Answers)
+	response.TimeSpent = time.Since(attempt.StartedAt)
+	for _, r := range attempt.Responses {
+		response.TimeSpent -= r.TimeSpent
+	}
+	attempt.Responses = append(attempt.Responses, response)
+	attempt.Level = stepLevel(attempt.Level, response.Correct)
+
+	var next *Question
+	if uint32(len(attempt.Responses)) < attempt.Length {
+		next = pickAdaptive(attempt, pool)
+	}
+	if next != nil {
+		attempt.QuestionIDs = append(attempt.QuestionIDs, next.ID)
+	} else {
+		finishAdaptive(attempt, byID)
+	}
+
+	var res *Attempt
+	err = u.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = u.repo.Advance(ctx, attempt, answered); err != nil {
+			return err
+		}
+		if next != nil {
+			return nil
+		}
+		return record(ctx, u.outbox, EventAttemptSubmitted, res.ID, res)
+	})
+	if err != nil {
+		u.log.Warn(err)
+		return nil, nil, nil, err
+	}
+	if next == nil {
+		_ = u.board.Record(ctx, res)
+	}
+	if next != nil {
+		if order, ok := res.AnswerOrders[next.ID]; ok {
+			next.Answers = orderAnswers(next.Answers, order)
+		}
+	}
+	return &response, next, res, nil
+}
+
+// pickAdaptive returns a question of the pool not asked yet whose difficulty is
+// closest to the attempt's level, choosing among equally close ones with a
+// generator seeded by the attempt seed and the number of questions asked.
+func pickAdaptive(attempt *Attempt, pool []*Question) *Question {
+	asked := make(map[string]bool, len(attempt.QuestionIDs))
+	for _, id := range attempt.QuestionIDs {
+		asked[id] = true
+	}
+	var candidates []*Question
+	best := math.MaxInt
+	// walk the pool in draw order so the pick does not depend on repo order
+	byID := make(map[string]*Question, len(pool))
+	for _, q := range pool {
+		byID[q.ID] = q
+	}
+	for _, id := range attempt.Pool {
+		q, ok := byID[id]
+		if !ok || asked[id] {
+			continue
+		}
+		distance := int(q.Difficulty) - int(attempt.Level)
+		if distance < 0 {
+			distance = -distance
+		}
+		switch {
+		case distance < best:
+			best = distance
+			candidates = []*Question{q}
+		case distance == best:
+			candidates = append(candidates, q)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	rng := rand.New(rand.NewSource(attempt.Seed + int64(len(attempt.QuestionIDs))))
+	return candidates[rng.Intn(len(candidates))]
+}
+
+// finishAdaptive closes the attempt and estimates the learner's ability as the
+// mean of the difficulty of each answered question, plus half a step when it
+// was answered correctly and minus half a step when it was not.
+func finishAdaptive(attempt *Attempt, questions map[string]*Question) {
+	var total, evidence float32
+	for _, r := range attempt.Responses {
+		total += r.Score
+		d := float32(EASY)
+		if q, ok := questions[r.QuestionID]; ok {
+			d = float32(q.Difficulty)
+		}
+		if r.Correct {
+			evidence += d + 0.5
+		} else {
+			evidence += d - 0.5
+		}
+	}
+	var score, ability float32
+	if n := float32(len(attempt.Responses)); n > 0 {
+		score = total / n
+		ability = evidence / n
+	}
+	ability = float32(math.Max(float64(EASY), math.Min(float64(EXPERT), float64(ability))))
+	now := time.Now().UTC()
+	attempt.Score = &score
+	attempt.Ability = &ability
+	attempt.SubmittedAt = &now
+}
+
+// stepLevel moves level one step up after a correct answer and one step down
+// after a wrong one, staying within EASY and EXPERT.
+func stepLevel(level Difficulty, correct bool) Difficulty {
+	if correct {
+		return clampDifficulty(int(level) + 1)
+	}
+	return clampDifficulty(int(level) - 1)
+}
+
+func clampDifficulty(level int) Difficulty {
+	if level < int(EASY) {
+		return EASY
+	}
+	if level > int(EXPERT) {
+		return EXPERT
+	}
+	return Difficulty(level)
+}
@@ -0,0 +1,142 @@
+package biz
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStepLevel(t *testing.T) {
+	tests := []struct {
+		level   Difficulty
+		correct bool
+		want    Difficulty
+	}{
+		{EASY, true, MEDIUM},
+		{MEDIUM, true, HARD},
+		{HARD, true, EXPERT},
+		{EXPERT, true, EXPERT},
+		{EXPERT, false, HARD},
+		{MEDIUM, false, EASY},
+		{EASY, false, EASY},
+	}
+	for _, tt := range tests {
+		if got := stepLevel(tt.level, tt.correct); got != tt.want {
+			t.Errorf("stepLevel(%d, %t) = %d, want %d", tt.level, tt.correct, got, tt.want)
+		}
+	}
+}
+
+func TestClampDifficulty(t *testing.T) {
+	tests := []struct {
+		level int
+		want  Difficulty
+	}{
+		{-3, EASY},
+		{0, EASY},
+		{2, HARD},
+		{3, EXPERT},
+		{9, EXPERT},
+	}
+	for _, tt := range tests {
+		if got := clampDifficulty(tt.level); got != tt.want {
+			t.Errorf("clampDifficulty(%d) = %d, want %d", tt.level, got, tt.want)
+		}
+	}
+}
+
+// adaptivePool returns questions e0, m0, m1, h0 and x0 with the difficulty
+// their first letter names.
+func adaptivePool() []*Question {
+	return []*Question{
+		{ID: "e0", Difficulty: uint64(EASY)},
+		{ID: "m0", Difficulty: uint64(MEDIUM)},
+		{ID: "m1", Difficulty: uint64(MEDIUM)},
+		{ID: "h0", Difficulty: uint64(HARD)},
+		{ID: "x0", Difficulty: uint64(EXPERT)},
+	}
+}
+
+func TestPickAdaptive(t *testing.T) {
+	order := []string{"e0", "m0", "m1", "h0", "x0"}
+	tests := []struct {
+		name  string
+		level Difficulty
+		pool  []string
+		asked []string
+		// want lists the questions the pick may return, empty for none
+		want []string
+	}{
+		{"exact level", HARD, order, nil, []string{"h0"}},
+		{"ties at level", MEDIUM, order, nil, []string{"m0", "m1"}},
+		{"skips asked", MEDIUM, order, []string{"m0"}, []string{"m1"}},
+		{"closest when level is used up", HARD, order, []string{"h0"}, []string{"m0", "m1", "x0"}},
+		{"only pool questions", EASY, []string{"h0", "x0"}, nil, []string{"h0"}},
+		{"deleted from the bank", EASY, []string{"gone", "x0"}, nil, []string{"x0"}},
+		{"all asked", EASY, []string{"e0"}, []string{"e0"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(0); seed < 10; seed++ {
+				attempt := &Attempt{Seed: seed, Level: tt.level, Pool: tt.pool, QuestionIDs: tt.asked}
+				got := pickAdaptive(attempt, adaptivePool())
+				if len(tt.want) == 0 {
+					if got != nil {
+						t.Fatalf("seed %d: picked %s, want none", seed, got.ID)
+					}
+					continue
+				}
+				if got == nil {
+					t.Fatalf("seed %d: picked none, want one of %v", seed, tt.want)
+				}
+				ok := false
+				for _, id := range tt.want {
+					ok = ok || got.ID == id
+				}
+				if !ok {
+					t.Fatalf("seed %d: picked %s, want one of %v", seed, got.ID, tt.want)
+				}
+				if again := pickAdaptive(attempt, adaptivePool()); again.ID != got.ID {
+					t.Fatalf("seed %d: picked %s, then %s", seed, got.ID, again.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestFinishAdaptive(t *testing.T) {
+	questions := make(map[string]*Question)
+	for _, q := range adaptivePool() {
+		questions[q.ID] = q
+	}
+	tests := []struct {
+		name        string
+		responses   []AttemptResponse
+		wantScore   float32
+		wantAbility float32
+	}{
+		{"none", nil, 0, 0},
+		{"right at medium", []AttemptResponse{{QuestionID: "m0", Score: 1, Correct: true}}, 1, 1.5},
+		{"wrong at easy stays at easy", []AttemptResponse{{QuestionID: "e0"}}, 0, 0},
+		{"right at expert stays at expert", []AttemptResponse{{QuestionID: "x0", Score: 1, Correct: true}}, 1, 3},
+		{"mixed", []AttemptResponse{
+			{QuestionID: "m0", Score: 1, Correct: true},
+			{QuestionID: "h0", Score: 0.5},
+		}, 0.75, 1.5},
+		{"deleted question counts as easy", []AttemptResponse{{QuestionID: "gone", Score: 1, Correct: true}}, 1, 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempt := &Attempt{Responses: tt.responses}
+			finishAdaptive(attempt, questions)
+			if attempt.SubmittedAt == nil {
+				t.Error("attempt not submitted")
+			}
+			if math.Abs(float64(*attempt.Score-tt.wantScore)) > 1e-6 {
+				t.Errorf("score = %v, want %v", *attempt.Score, tt.wantScore)
+			}
+			if math.Abs(float64(*attempt.Ability-tt.wantAbility)) > 1e-6 {
+				t.Errorf("ability = %v, want %v", *attempt.Ability, tt.wantAbility)
+			}
+		})
+	}
+}
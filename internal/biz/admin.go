@@ -0,0 +1,41 @@
+package biz
+
+import (
+	"context"
+
+	"quiz/internal/conf"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+// Admins are the users listed under admin.user_ids, trusted with operations
+// that bypass the usual ownership rules.
+type Admins struct {
+	ids map[string]bool
+}
+
+func NewAdmins(bc *conf.Bootstrap) *Admins {
+	a := &Admins{ids: make(map[string]bool)}
+	for _, id := range bc.GetAdmin().GetUserIds() {
+		if id != "" {
+			a.ids[id] = true
+		}
+	}
+	return a
+}
+
+func (a *Admins) IsAdmin(userID string) bool {
+	return userID != "" && a.ids[userID]
+}
+
+// Authorize returns nil when the caller in ctx is an admin.
+func (a *Admins) Authorize(ctx context.Context) error {
+	userID := ActorFromContext(ctx)
+	if userID == "" {
+		return pb.ErrorUnauthorized("sign in as an admin")
+	}
+	if !a.ids[userID] {
+		return pb.ErrorForbidden("only admins may do this")
+	}
+	return nil
+}
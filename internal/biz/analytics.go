@@ -0,0 +1,157 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	defaultMinResponses = 10
+
+	// FlagSuspectKey marks a question whose answer key is probably wrong: the
+	// learners who got it right did worse overall than those who did not, or a
+	// wrong answer was picked more often than every correct one.
+	FlagSuspectKey = "suspect_key"
+	FlagTooEasy    = "too_easy"
+	FlagTooHard    = "too_hard"
+)
+
+// ItemStats are the raw per-question sums over the submitted attempts of a quiz.
+type ItemStats struct {
+	QuestionID string
+	Responses  int64
+	Correct    int64
+	// sums of the attempt scores of the responses that were correct and incorrect
+	ScoreCorrect   float64
+	ScoreIncorrect float64
+	AverageTime    time.Duration
+	// Chosen counts the responses that checked each answer ID.
+	Chosen map[string]int64
+}
+
+type QuizItemStats struct {
+	Attempts    int64
+	ScoreStdDev float64
+	Items       []*ItemStats
+}
+
+type AnswerStats struct {
+	Answer     Answer
+	Chosen     int64
+	ChoiceRate float32
+}
+
+type QuestionAnalytics struct {
+	Question       *Question
+	Responses      int64
+	PValue         float32
+	Discrimination *float32
+	AverageTime    time.Duration
+	Answers        []AnswerStats
+	Flags          []string
+}
+
+type QuizAnalytics struct {
+	QuizID    string
+	Attempts  int64
+	Questions []*QuestionAnalytics
+}
+
+// GetQuizAnalytics runs item analysis over the submitted attempts of a quiz.
+// Questions with fewer than minResponses responses are never flagged.
+func (u *ResultsUsecase) GetQuizAnalytics(ctx context.Context, quizID string, callerID string, minResponses uint32) (*QuizAnalytics, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.ResultsUsecase.GetQuizAnalytics")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", quizID))
+
+	if err := u.authorizeAuthor(ctx, quizID, callerID); err != nil {
+		return nil, err
+	}
+	if minResponses == 0 {
+		minResponses = defaultMinResponses
+	}
+
+	stats, err := u.repo.ItemStats(ctx, quizID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	ids := make([]string, 0, len(stats.Items))
+	for _, item := range stats.Items {
+		ids = append(ids, item.QuestionID)
+	}
+	questions, err := u.questions.ListByIDs(ctx, ids)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	byID := make(map[string]*Question, len(questions))
+	for _, q := range questions {
+		byID[q.ID] = q
+	}
+
+	res := &QuizAnalytics{QuizID: quizID, Attempts: stats.Attempts}
+	for _, item := range stats.Items {
+		q, ok := byID[item.QuestionID]
+		if !ok {
+			// deleted since the attempts were taken
+			continue
+		}
+		res.Questions = append(res.Questions, analyzeItem(q, item, stats.ScoreStdDev, int64(minResponses)))
+	}
+	return res, nil
+}
+
+func analyzeItem(q *Question, item *ItemStats, sd float64, minResponses int64) *QuestionAnalytics {
+	a := &QuestionAnalytics{
+		Question:    q,
+		Responses:   item.Responses,
+		AverageTime: item.AverageTime,
+	}
+	if item.Responses == 0 {
+		return a
+	}
+	n := float64(item.Responses)
+	p := float64(item.Correct) / n
+	a.PValue = float32(p)
+
+	// r_pb = (M1 - M0) / sd * sqrt(p * (1 - p))
+	if sd > 0 && item.Correct > 0 && item.Correct < item.Responses {
+		m1 := item.ScoreCorrect / float64(item.Correct)
+		m0 := item.ScoreIncorrect / float64(item.Responses-item.Correct)
+		d := float32((m1 - m0) / sd * math.Sqrt(p*(1-p)))
+		a.Discrimination = &d
+	}
+
+	var bestCorrect, bestWrong int64
+	for _, ans := range q.Answers {
+		chosen := item.Chosen[ans.ID]
+		a.Answers = append(a.Answers, AnswerStats{
+			Answer:     ans,
+			Chosen:     chosen,
+			ChoiceRate: float32(float64(chosen) / n),
+		})
+		if ans.IsCorrect() {
+			bestCorrect = max(bestCorrect, chosen)
+		} else {
+			bestWrong = max(bestWrong, chosen)
+		}
+	}
+
+	if item.Responses < minResponses {
+		return a
+	}
+	if (a.Discrimination != nil && *a.Discrimination < 0) || bestWrong > bestCorrect {
+		a.Flags = append(a.Flags, FlagSuspectKey)
+	}
+	switch {
+	case p >= 0.95:
+		a.Flags = append(a.Flags, FlagTooEasy)
+	case p <= 0.2:
+		a.Flags = append(a.Flags, FlagTooHard)
+	}
+	return a
+}
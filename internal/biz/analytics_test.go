@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestAnalyzeItem(t *testing.T) {
+	// a is the only correct answer
+	q := &Question{ID: "q", Answers: []Answer{{ID: "a", isCorrect: true}, {ID: "b"}, {ID: "c"}}}
+	disc := func(d float32) *float32 { return &d }
+	tests := []struct {
+		name     string
+		item     ItemStats
+		sd       float64
+		wantP    float32
+		wantDisc *float32
+		wantFlag []string
+	}{
+		{
+			name: "no responses",
+			item: ItemStats{},
+			sd:   0.2,
+		},
+		{
+			name:  "too few responses to flag",
+			item:  ItemStats{Responses: 5, Correct: 5, ScoreCorrect: 5, Chosen: map[string]int64{"a": 5}},
+			sd:    0.2,
+			wantP: 1,
+		},
+		{
+			name: "healthy",
+			item: ItemStats{Responses: 20, Correct: 12, ScoreCorrect: 9.6, ScoreIncorrect: 4,
+				Chosen: map[string]int64{"a": 12, "b": 5, "c": 3}},
+			sd:       0.3,
+			wantP:    0.6,
+			wantDisc: disc(float32(0.3 / 0.3 * math.Sqrt(0.6*0.4))),
+		},
+		{
+			name:     "too easy",
+			item:     ItemStats{Responses: 20, Correct: 20, ScoreCorrect: 16, Chosen: map[string]int64{"a": 20}},
+			sd:       0.2,
+			wantP:    1,
+			wantFlag: []string{FlagTooEasy},
+		},
+		{
+			name: "too hard",
+			item: ItemStats{Responses: 20, Correct: 2, ScoreCorrect: 1.8, ScoreIncorrect: 7.2,
+				Chosen: map[string]int64{"a": 2, "b": 2, "c": 1}},
+			sd:       0.25,
+			wantP:    0.1,
+			wantDisc: disc(float32(0.5 / 0.25 * math.Sqrt(0.1*0.9))),
+			wantFlag: []string{FlagTooHard},
+		},
+		{
+			name: "right answers from weaker learners",
+			item: ItemStats{Responses: 20, Correct: 10, ScoreCorrect: 3, ScoreIncorrect: 7,
+				Chosen: map[string]int64{"a": 10, "b": 5}},
+			sd:       0.2,
+			wantP:    0.5,
+			wantDisc: disc(-1),
+			wantFlag: []string{FlagSuspectKey},
+		},
+		{
+			name: "wrong answer more popular",
+			item: ItemStats{Responses: 20, Correct: 10, ScoreCorrect: 8, ScoreIncorrect: 4,
+				Chosen: map[string]int64{"a": 10, "b": 12}},
+			sd:       0.4,
+			wantP:    0.5,
+			wantDisc: disc(0.5),
+			wantFlag: []string{FlagSuspectKey},
+		},
+		{
+			name: "no spread in scores",
+			item: ItemStats{Responses: 20, Correct: 10, ScoreCorrect: 5, ScoreIncorrect: 5,
+				Chosen: map[string]int64{"a": 10}},
+			wantP: 0.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := analyzeItem(q, &tt.item, tt.sd, 10)
+			if math.Abs(float64(got.PValue-tt.wantP)) > 1e-6 {
+				t.Errorf("p = %v, want %v", got.PValue, tt.wantP)
+			}
+			switch {
+			case tt.wantDisc == nil && got.Discrimination != nil:
+				t.Errorf("discrimination = %v, want none", *got.Discrimination)
+			case tt.wantDisc != nil && got.Discrimination == nil:
+				t.Errorf("no discrimination, want %v", *tt.wantDisc)
+			case tt.wantDisc != nil && math.Abs(float64(*got.Discrimination-*tt.wantDisc)) > 1e-5:
+				t.Errorf("discrimination = %v, want %v", *got.Discrimination, *tt.wantDisc)
+			}
+			if !slices.Equal(got.Flags, tt.wantFlag) {
+				t.Errorf("flags = %v, want %v", got.Flags, tt.wantFlag)
+			}
+			if tt.item.Responses == 0 {
+				if len(got.Answers) != 0 {
+					t.Errorf("%d answer stats without responses", len(got.Answers))
+				}
+				return
+			}
+			if len(got.Answers) != len(q.Answers) {
+				t.Fatalf("%d answer stats, want %d", len(got.Answers), len(q.Answers))
+			}
+			for _, a := range got.Answers {
+				want := float32(float64(tt.item.Chosen[a.Answer.ID]) / float64(tt.item.Responses))
+				if a.Chosen != tt.item.Chosen[a.Answer.ID] || math.Abs(float64(a.ChoiceRate-want)) > 1e-6 {
+					t.Errorf("answer %s: chosen %d at %v, want %d at %v", a.Answer.ID, a.Chosen, a.ChoiceRate, tt.item.Chosen[a.Answer.ID], want)
+				}
+			}
+		})
+	}
+}
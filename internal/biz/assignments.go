@@ -0,0 +1,412 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+// Assignment schedules a quiz for a group. Members may start attempts between
+// OpensAt and ClosesAt, at most MaxAttempts of them unless it is 0.
+type Assignment struct {
+	ID          string     `json:"id"`
+	QuizID      string     `json:"quiz_id"`
+	GroupID     string     `json:"group_id"`
+	OwnerID     string     `json:"owner_id"`
+	OpensAt     time.Time  `json:"opens_at"`
+	ClosesAt    *time.Time `json:"closes_at"`
+	DueAt       *time.Time `json:"due_at"`
+	MaxAttempts uint32     `json:"max_attempts"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// IsOpen reports whether attempts may be started at t.
+func (a *Assignment) IsOpen(t time.Time) bool {
+	return !t.Before(a.OpensAt) && (a.ClosesAt == nil || t.Before(*a.ClosesAt))
+}
+
+// AssignmentUpdate holds the fields to change; nil leaves a field as it is and
+// a zero ClosesAt or DueAt clears it.
+type AssignmentUpdate struct {
+	ID          string
+	OpensAt     *time.Time
+	ClosesAt    *time.Time
+	DueAt       *time.Time
+	MaxAttempts *uint32
+}
+
+// AssignmentFilter matches the assignments made by OwnerID or to any of
+// GroupIDs.
+type AssignmentFilter struct {
+	OwnerID  string
+	GroupIDs []string
+}
+
+// AssignmentProgress sums up the attempts one user started for an assignment.
+type AssignmentProgress struct {
+	UserID    string
+	Attempts  uint32
+	BestScore *float32
+	// CompletedAt is the first submission, nil until there is one.
+	CompletedAt *time.Time
+}
+
+type RosterEntry struct {
+	AssignmentProgress
+	Completed bool
+	Late      bool
+}
+
+// Roster lists every member of the assigned group, those who haven't completed
+// the assignment first.
+type Roster struct {
+	Assignment *Assignment
+	Entries    []*RosterEntry
+	Completed  uint32
+	Pending    uint32
+}
+
+type AssignmentsRepo interface {
+	Save(ctx context.Context, a *Assignment) (*Assignment, error)
+	GetByID(ctx context.Context, id string) (*Assignment, error)
+	List(ctx context.Context, filter *AssignmentFilter, pagination *Pagination) ([]*Assignment, error)
+	// ListForQuiz returns the assignments of quizID to any of groupIDs.
+	ListForQuiz(ctx context.Context, quizID string, groupIDs []string) ([]*Assignment, error)
+	// IsAssigned reports whether quizID is assigned to any group.
+	IsAssigned(ctx context.Context, quizID string) (bool, error)
+	// TakeAttempt counts one more attempt of userID toward assignmentID and
+	// returns true, or returns false when max are counted already. used is the
+	// count to start from when there is no counter yet.
+	TakeAttempt(ctx context.Context, assignmentID string, userID string, max uint32, used int64) (bool, error)
+	// Update stores the window and attempt limit of a.
+	Update(ctx context.Context, a *Assignment) (*Assignment, error)
+	Delete(ctx context.Context, id string) error
+	DeleteByGroup(ctx context.Context, groupID string) error
+}
+
+type AssignmentsUsecase struct {
+	repo          AssignmentsRepo
+	groups        GroupsRepo
+	quizzes       QuizRepo
+	collaborators CollaboratorsRepo
+	attempts      AttemptsRepo
+	log           *log.Helper
+	tracer        trace.Tracer
+}
+
+func NewAssignmentsUsecase(repo AssignmentsRepo, groups GroupsRepo, quizzes QuizRepo, collaborators CollaboratorsRepo, attempts AttemptsRepo, logger log.Logger, tracer trace.Tracer) *AssignmentsUsecase {
+	return &AssignmentsUsecase{
+		repo:          repo,
+		groups:        groups,
+		quizzes:       quizzes,
+		collaborators: collaborators,
+		attempts:      attempts,
+		log:           log.NewHelper(logger),
+		tracer:        tracer,
+	}
+}
+
+// CreateAssignment assigns a quiz userID may edit to a group they own. The
+// assignment opens right away unless OpensAt is set.
+func (u *AssignmentsUsecase) CreateAssignment(ctx context.Context, a *Assignment, userID string) (*Assignment, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.AssignmentsUsecase.CreateAssignment")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", a.QuizID), attribute.String("group_id", a.GroupID))
+
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to assign a quiz")
+	}
+	group, err := u.groups.GetByID(ctx, a.GroupID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if group.OwnerID != userID {
+		return nil, pb.ErrorForbidden("only the owner of a group can assign it quizzes")
+	}
+	quiz, err := u.quizzes.GetByID(ctx, a.QuizID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if err := authorizeEdit(ctx, u.collaborators, quiz, userID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if a.OpensAt.IsZero() {
+		a.OpensAt = now
+	}
+	if err := validateAssignment(a); err != nil {
+		return nil, err
+	}
+	a.OwnerID = userID
+	a.CreatedAt = now
+	res, err := u.repo.Save(ctx, a)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAssignment returns an assignment to its owner and the members of its group.
+func (u *AssignmentsUsecase) GetAssignment(ctx context.Context, id string, userID string) (*Assignment, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.AssignmentsUsecase.GetAssignment")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to see an assignment")
+	}
+	a, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if a.OwnerID == userID {
+		return a, nil
+	}
+	group, err := u.groups.GetByID(ctx, a.GroupID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if !group.HasMember(userID) {
+		return nil, pb.ErrorForbidden("only the owner and the assigned group can see an assignment")
+	}
+	return a, nil
+}
+
+// ListAssignments lists the assignments of groupID, or when it is empty those
+// userID made or was given through any of their groups.
+func (u *AssignmentsUsecase) ListAssignments(ctx context.Context, groupID string, userID string, pagination *Pagination) ([]*Assignment, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.AssignmentsUsecase.ListAssignments")
+	defer span.End()
+	span.SetAttributes(attribute.String("group_id", groupID))
+
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to see assignments")
+	}
+	filter := &AssignmentFilter{}
+	if groupID != "" {
+		group, err := u.groups.GetByID(ctx, groupID)
+		if err != nil {
+			u.log.Warn(err)
+			return nil, err
+		}
+		if group.OwnerID != userID && !group.HasMember(userID) {
+			return nil, pb.ErrorForbidden("only the owner and members of a group can see its assignments")
+		}
+		filter.GroupIDs = []string{groupID}
+	} else {
+		groupIDs, err := u.groups.MemberOf(ctx, userID)
+		if err != nil {
+			u.log.Warn(err)
+			return nil, err
+		}
+		filter.OwnerID = userID
+		filter.GroupIDs = groupIDs
+	}
+	res, err := u.repo.List(ctx, filter, pagination)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *AssignmentsUsecase) UpdateAssignment(ctx context.Context, update *AssignmentUpdate, userID string) (*Assignment, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.AssignmentsUsecase.UpdateAssignment")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", update.ID))
+
+	a, err := u.owned(ctx, update.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if update.OpensAt != nil {
+		a.OpensAt = *update.OpensAt
+	}
+	if update.ClosesAt != nil {
+		a.ClosesAt = update.ClosesAt
+		if update.ClosesAt.IsZero() {
+			a.ClosesAt = nil
+		}
+	}
+	if update.DueAt != nil {
+		a.DueAt = update.DueAt
+		if update.DueAt.IsZero() {
+			a.DueAt = nil
+		}
+	}
+	if update.MaxAttempts != nil {
+		a.MaxAttempts = *update.MaxAttempts
+	}
+	if err := validateAssignment(a); err != nil {
+		return nil, err
+	}
+	res, err := u.repo.Update(ctx, a)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteAssignment deletes an assignment. Attempts made for it are kept.
+func (u *AssignmentsUsecase) DeleteAssignment(ctx context.Context, id string, userID string) error {
+	ctx, span := u.tracer.Start(ctx, "biz.AssignmentsUsecase.DeleteAssignment")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	if _, err := u.owned(ctx, id, userID); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, id); err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	return nil
+}
+
+// GetRoster reports to the owner of an assignment how far each member of the
+// group got with it.
+func (u *AssignmentsUsecase) GetRoster(ctx context.Context, id string, userID string) (*Roster, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.AssignmentsUsecase.GetRoster")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	a, err := u.owned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	group, err := u.groups.GetByID(ctx, a.GroupID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	progress, err := u.attempts.AssignmentProgress(ctx, a.ID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	byUser := make(map[string]*AssignmentProgress, len(progress))
+	for _, p := range progress {
+		byUser[p.UserID] = p
+	}
+
+	now := time.Now()
+	roster := &Roster{Assignment: a, Entries: make([]*RosterEntry, 0, len(group.MemberIDs))}
+	for _, memberID := range group.MemberIDs {
+		entry := &RosterEntry{AssignmentProgress: AssignmentProgress{UserID: memberID}}
+		if p, ok := byUser[memberID]; ok {
+			entry.AssignmentProgress = *p
+		}
+		entry.Completed = entry.CompletedAt != nil
+		if a.DueAt != nil {
+			if entry.Completed {
+				entry.Late = entry.CompletedAt.After(*a.DueAt)
+			} else {
+				entry.Late = now.After(*a.DueAt)
+			}
+		}
+		if entry.Completed {
+			roster.Completed++
+		} else {
+			roster.Pending++
+		}
+		roster.Entries = append(roster.Entries, entry)
+	}
+	sort.SliceStable(roster.Entries, func(i, j int) bool {
+		ei, ej := roster.Entries[i], roster.Entries[j]
+		if ei.Completed != ej.Completed {
+			return !ei.Completed
+		}
+		return ei.UserID < ej.UserID
+	})
+	return roster, nil
+}
+
+// assignmentFor picks the assignment a new attempt of userID at quizID counts
+// toward, and takes one of its attempts: the open one with attempts left that
+// is due first. It returns "" when the quiz isn't assigned to any group of
+// userID, leaving the attempt unrestricted. Assigned quizzes cannot be taken
+// anonymously, which would escape the window and attempt limit.
+func (u *AssignmentsUsecase) assignmentFor(ctx context.Context, quizID string, userID string) (string, error) {
+	if userID == "" {
+		assigned, err := u.repo.IsAssigned(ctx, quizID)
+		if err != nil {
+			return "", err
+		}
+		if assigned {
+			return "", pb.ErrorUnauthorized("sign in to take an assigned quiz")
+		}
+		return "", nil
+	}
+	groupIDs, err := u.groups.MemberOf(ctx, userID)
+	if err != nil || len(groupIDs) == 0 {
+		return "", err
+	}
+	assignments, err := u.repo.ListForQuiz(ctx, quizID, groupIDs)
+	if err != nil || len(assignments) == 0 {
+		return "", err
+	}
+	sort.SliceStable(assignments, func(i, j int) bool {
+		di, dj := assignments[i].DueAt, assignments[j].DueAt
+		return di != nil && (dj == nil || di.Before(*dj))
+	})
+
+	now := time.Now()
+	open := false
+	for _, a := range assignments {
+		if !a.IsOpen(now) {
+			continue
+		}
+		open = true
+		if a.MaxAttempts == 0 {
+			return a.ID, nil
+		}
+		// seeds the counter of assignments made before there was one
+		n, err := u.attempts.CountForAssignment(ctx, a.ID, userID)
+		if err != nil {
+			return "", err
+		}
+		if n >= int64(a.MaxAttempts) {
+			continue
+		}
+		taken, err := u.repo.TakeAttempt(ctx, a.ID, userID, a.MaxAttempts, n)
+		if err != nil {
+			return "", err
+		}
+		if taken {
+			return a.ID, nil
+		}
+	}
+	if !open {
+		return "", pb.ErrorAssignmentClosed("no assignment of this quiz is open")
+	}
+	return "", pb.ErrorAttemptLimitReached("every attempt of this assignment was used")
+}
+
+// owned returns assignment id after checking userID made it.
+func (u *AssignmentsUsecase) owned(ctx context.Context, id string, userID string) (*Assignment, error) {
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to manage assignments")
+	}
+	a, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if a.OwnerID != userID {
+		return nil, pb.ErrorForbidden("only the owner of an assignment can manage it")
+	}
+	return a, nil
+}
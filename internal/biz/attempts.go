@@ -0,0 +1,456 @@
+package biz
+
+import (
+	"context"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"math/rand"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	pb "quiz/api/quizzes/v1"
+)
+
+type AttemptAnswer struct {
+	AnswerID string `json:"answer_id"`
+	Checked  bool   `json:"checked"`
+}
+
+type AttemptResponse struct {
+	QuestionID string          `json:"question_id"`
+	Answers    []AttemptAnswer `json:"answers"`
+	Score      float32         `json:"score"`
+	Correct    bool            `json:"correct"`
+	TimeSpent  time.Duration   `json:"time_spent"`
+}
+
+// Attempt is one run of a user through a quiz. The questions are drawn once,
+// when the attempt starts, and kept for the lifetime of the attempt.
+type Attempt struct {
+	ID     string `json:"id"`
+	QuizID string `json:"quiz_id"`
+	UserID string `json:"user_id"`
+	// AssignmentID is the assignment the attempt counts toward, if any.
+	AssignmentID string   `json:"assignment_id"`
+	Seed         int64    `json:"seed"`
+	QuestionIDs  []string `json:"question_ids"`
+	// AnswerOrders maps a question to the answer IDs in the order the
+	// attempt presents them; absent for questions shown as authored.
+	AnswerOrders map[string][]string `json:"answer_orders"`
+	Responses    []AttemptResponse   `json:"responses"`
+	Score        *float32            `json:"score"`
+	StartedAt    time.Time           `json:"started_at"`
+	SubmittedAt  *time.Time          `json:"submitted_at"`
+	// Adaptive attempts ask one question at a time out of Pool, see adaptive.go.
+	Adaptive bool       `json:"adaptive"`
+	Pool     []string   `json:"pool"`
+	Length   uint32     `json:"length"`
+	Level    Difficulty `json:"level"`
+	Ability  *float32   `json:"ability"`
+	// Token lets an anonymous caller back into the attempt. It is only set on
+	// the attempt StartAttempt returns; the repository keeps its hash.
+	Token     string `json:"-"`
+	TokenHash string `json:"-"`
+}
+
+type attemptTokenKey struct{}
+
+// WithAttemptToken returns a context carrying the token an anonymous caller
+// presents for their attempt.
+func WithAttemptToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, attemptTokenKey{}, token)
+}
+
+func attemptTokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(attemptTokenKey{}).(string)
+	return token
+}
+
+func hashAttemptToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+type AttemptsRepo interface {
+	Save(ctx context.Context, a *Attempt) (*Attempt, error)
+	GetByID(ctx context.Context, id string) (*Attempt, error)
+	// Submit stores the graded responses unless the attempt was already submitted.
+	Submit(ctx context.Context, a *Attempt) (*Attempt, error)
+	// Advance stores the progress of an adaptive attempt unless another answer
+	// was recorded since it had answered responses.
+	Advance(ctx context.Context, a *Attempt, answered int) (*Attempt, error)
+	// ForEachSubmitted calls fn with every submitted attempt of a quiz, stopping
+	// at the first error.
+	ForEachSubmitted(ctx context.Context, quizID string, fn func(*Attempt) error) error
+	CountForAssignment(ctx context.Context, assignmentID string, userID string) (int64, error)
+	// AssignmentProgress returns one entry per user who started an attempt for
+	// the assignment.
+	AssignmentProgress(ctx context.Context, assignmentID string) ([]*AssignmentProgress, error)
+}
+
+type AttemptsUsecase struct {
+	repo        AttemptsRepo
+	quizzes     QuizRepo
+	questions   QuestionsRepo
+	gate        *PremiumGate
+	assignments *AssignmentsUsecase
+	board       *LeaderboardUsecase
+	admins      *Admins
+	limiter     *RateLimiter
+	tx          Transaction
+	outbox      OutboxRepo
+	log         *log.Helper
+	tracer      trace.Tracer
+}
+
+func NewAttemptsUsecase(repo AttemptsRepo, quizzes QuizRepo, questions QuestionsRepo, gate *PremiumGate, assignments *AssignmentsUsecase, board *LeaderboardUsecase, admins *Admins, limiter *RateLimiter, tx Transaction, outbox OutboxRepo, logger log.Logger, tracer trace.Tracer) *AttemptsUsecase {
+	return &AttemptsUsecase{
+		repo:        repo,
+		quizzes:     quizzes,
+		questions:   questions,
+		gate:        gate,
+		assignments: assignments,
+		board:       board,
+		admins:      admins,
+		limiter:     limiter,
+		tx:          tx,
+		outbox:      outbox,
+		log:         log.NewHelper(logger),
+		tracer:      tracer,
+	}
+}
+
+// StartAttempt draws the questions of a new attempt and fixes their order. A
+// nil seed picks a fresh one; passing the seed of an earlier attempt replays its
+// draw and shuffle as long as the bank has not changed in between, which only
+// the author of the quiz and admins may do, so that takers cannot pick a draw
+// they already know the answers to. When the quiz is assigned to a group of
+// the user, the attempt has to fit the window and attempt limit of one of
+// those assignments.
+func (u *AttemptsUsecase) StartAttempt(ctx context.Context, quizID string, userID string, seed *int64) (*Attempt, []*Question, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.AttemptsUsecase.StartAttempt")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", quizID), attribute.String("user_id", userID))
+
+	quiz, err := u.quizzes.GetByID(ctx, quizID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, nil, err
+	}
+	if err := u.gate.Authorize(ctx, userID, quiz); err != nil {
+		return nil, nil, err
+	}
+	if seed != nil && (userID == "" || userID != quiz.UserID && !u.admins.IsAdmin(userID)) {
+		return nil, nil, pb.ErrorForbidden("only the author of the quiz or an admin may choose the seed")
+	}
+
+	s := rand.Int63()
+	if seed != nil {
+		s = *seed
+	}
+	rng := rand.New(rand.NewSource(s))
+	ids, err := u.drawQuestions(ctx, quiz, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil, pb.ErrorInvalidArgument("quiz has no questions")
+	}
+	span.SetAttributes(attribute.Int64("seed", s), attribute.Int("questions", len(ids)))
+
+	if quiz.Shuffle != nil && quiz.Shuffle.Questions {
+		rng.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+	}
+	attempt := &Attempt{
+		QuizID:      quiz.ID,
+		UserID:      userID,
+		Seed:        s,
+		QuestionIDs: ids,
+		StartedAt:   time.Now().UTC(),
+	}
+	if quiz.Shuffle != nil && quiz.Shuffle.Answers {
+		questions, err := u.attemptQuestions(ctx, attempt)
+		if err != nil {
+			return nil, nil, err
+		}
+		attempt.AnswerOrders = make(map[string][]string, len(questions))
+		for _, q := range questions {
+			attempt.AnswerOrders[q.ID] = shuffleAnswers(rng, q.Answers)
+		}
+	}
+	if quiz.Adaptive != nil {
+		if err := u.startAdaptive(ctx, attempt, quiz.Adaptive); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// taken last, so that an attempt failing to start does not use one up
+	attempt.AssignmentID, err = u.assignments.assignmentFor(ctx, quiz.ID, userID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, nil, err
+	}
+	// anonymous attempts are reached through a token instead of the user ID
+	var token string
+	if userID == "" {
+		b := make([]byte, 32)
+		if _, err := crand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		token = hex.EncodeToString(b)
+		attempt.TokenHash = hashAttemptToken(token)
+	}
+
+	attempt, err = u.repo.Save(ctx, attempt)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, nil, err
+	}
+	attempt.Token = token
+	questions, err := u.attemptQuestions(ctx, attempt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return attempt, questions, nil
+}
+
+func (u *AttemptsUsecase) GetAttempt(ctx context.Context, id string, userID string) (*Attempt, []*Question, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.AttemptsUsecase.GetAttempt")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	attempt, err := u.getOwn(ctx, id, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	questions, err := u.attemptQuestions(ctx, attempt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return attempt, questions, nil
+}
+
+// SubmitAttempt grades the responses against the drawn questions. Questions
+// left out of responses count as answered with nothing checked. Each question
+// graded counts against the validation cap of the caller, as the graded
+// responses tell which answers are correct just like ValidateQuestionAnswers.
+func (u *AttemptsUsecase) SubmitAttempt(ctx context.Context, id string, userID string, responses []AttemptResponse) (*Attempt, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.AttemptsUsecase.SubmitAttempt")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	attempt, err := u.getOwn(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if attempt.SubmittedAt != nil {
+		return nil, pb.ErrorAttemptAlreadySubmitted("attempt already submitted")
+	}
+	if attempt.Adaptive {
+		return nil, pb.ErrorInvalidArgument("adaptive attempts are answered one question at a time")
+	}
+	questions, err := u.attemptQuestions(ctx, attempt)
+	if err != nil {
+		return nil, err
+	}
+	for _, q := range questions {
+		if err := u.limiter.AllowValidation(ctx, q.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	drawn := make(map[string]bool, len(attempt.QuestionIDs))
+	for _, id := range attempt.QuestionIDs {
+		drawn[id] = true
+	}
+	submitted := make(map[string]AttemptResponse, len(responses))
+	for _, r := range responses {
+		if !drawn[r.QuestionID] {
+			return nil, pb.ErrorInvalidArgument("question %s is not part of the attempt", r.QuestionID)
+		}
+		submitted[r.QuestionID] = r
+	}
+	graded := make([]AttemptResponse, 0, len(questions))
+	var total float32
+	for _, q := range questions {
+		r := submitted[q.ID]
+		r.QuestionID = q.ID
+		r.Score, r.Correct = gradeResponse(q, r.Answers)
+		total += r.Score
+		graded = append(graded, r)
+	}
+
+	var score float32
+	if len(graded) > 0 {
+		score = total / float32(len(graded))
+	}
+	now := time.Now().UTC()
+	attempt.Responses = graded
+	attempt.Score = &score
+	attempt.SubmittedAt = &now
+
+	var res *Attempt
+	err = u.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = u.repo.Submit(ctx, attempt); err != nil {
+			return err
+		}
+		return record(ctx, u.outbox, EventAttemptSubmitted, res.ID, res)
+	})
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	// the attempt stands even if ranking it fails; a rebuild catches it up
+	_ = u.board.Record(ctx, res)
+	return res, nil
+}
+
+// drawQuestions returns the quiz's own questions, then the bank questions it
+// references, then the random picks of each draw rule. Candidates come back
+// from the repo in a stable order so the same seed yields the same picks.
+func (u *AttemptsUsecase) drawQuestions(ctx context.Context, quiz *Quiz, rng *rand.Rand) ([]string, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.AttemptsUsecase.drawQuestions")
+	defer span.End()
+
+	owned, err := u.questions.MatchIDs(ctx, &QuestionFilter{QuizID: quiz.ID})
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	ids := make([]string, 0, len(owned)+len(quiz.QuestionIDs))
+	for _, id := range append(owned, quiz.QuestionIDs...) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	for _, rule := range quiz.DrawRules {
+		candidates, err := u.questions.MatchIDs(ctx, &QuestionFilter{Tags: rule.Tags, Difficulty: rule.Difficulty})
+		if err != nil {
+			u.log.Warn(err)
+			return nil, err
+		}
+		pool := make([]string, 0, len(candidates))
+		for _, id := range candidates {
+			if !seen[id] {
+				pool = append(pool, id)
+			}
+		}
+		rng.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+		n := int(rule.Count)
+		if n > len(pool) {
+			u.log.Warnf("quiz %s: draw rule wants %d questions, bank has %d", quiz.ID, n, len(pool))
+			n = len(pool)
+		}
+		for _, id := range pool[:n] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// attemptQuestions loads the drawn questions in the order of the attempt, with
+// their answers in the order recorded for it.
+func (u *AttemptsUsecase) attemptQuestions(ctx context.Context, attempt *Attempt) ([]*Question, error) {
+	questions, err := u.questions.ListByIDs(ctx, attempt.QuestionIDs)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	byID := make(map[string]*Question, len(questions))
+	for _, q := range questions {
+		byID[q.ID] = q
+	}
+	res := make([]*Question, 0, len(attempt.QuestionIDs))
+	for _, id := range attempt.QuestionIDs {
+		// questions deleted after the draw drop out of the attempt
+		if q, ok := byID[id]; ok {
+			if order, ok := attempt.AnswerOrders[id]; ok {
+				q.Answers = orderAnswers(q.Answers, order)
+			}
+			res = append(res, q)
+		}
+	}
+	return res, nil
+}
+
+func (u *AttemptsUsecase) getOwn(ctx context.Context, id string, userID string) (*Attempt, error) {
+	attempt, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if attempt.UserID == "" {
+		// attempts started before tokens existed have no hash and stay closed
+		given := hashAttemptToken(attemptTokenFromContext(ctx))
+		if attempt.TokenHash == "" || subtle.ConstantTimeCompare([]byte(given), []byte(attempt.TokenHash)) != 1 {
+			return nil, pb.ErrorForbidden("attempt token is missing or does not match")
+		}
+	} else if attempt.UserID != userID {
+		return nil, pb.ErrorForbidden("attempt belongs to another user")
+	}
+	return attempt, nil
+}
+
+func gradeResponse(q *Question, answers []AttemptAnswer) (float32, bool) {
+	checked := make(map[string]bool, len(answers))
+	for _, a := range answers {
+		checked[a.AnswerID] = a.Checked
+	}
+	userAnswers := make([]*pb.UserAnswer, 0, len(q.Answers))
+	for _, a := range q.Answers {
+		userAnswers = append(userAnswers, &pb.UserAnswer{AnswerId: a.ID, Checked: checked[a.ID]})
+	}
+	_, score, err := validateAnswers(q.Answers, userAnswers)
+	if err != nil || len(q.Answers) == 0 {
+		return 0, false
+	}
+	return score, score == 100
+}
+
+// shuffleAnswers returns the answer IDs in a random order, leaving pinned
+// answers such as "All of the above" at their authored position.
+func shuffleAnswers(rng *rand.Rand, answers []Answer) []string {
+	ids := make([]string, len(answers))
+	var free []int
+	for i, a := range answers {
+		ids[i] = a.ID
+		if !a.Pinned {
+			free = append(free, i)
+		}
+	}
+	rng.Shuffle(len(free), func(i, j int) {
+		ids[free[i]], ids[free[j]] = ids[free[j]], ids[free[i]]
+	})
+	return ids
+}
+
+// orderAnswers sorts answers by order. Answers added after the attempt
+// started are not part of order and go last, as authored.
+func orderAnswers(answers []Answer, order []string) []Answer {
+	byID := make(map[string]Answer, len(answers))
+	for _, a := range answers {
+		byID[a.ID] = a
+	}
+	res := make([]Answer, 0, len(answers))
+	for _, id := range order {
+		if a, ok := byID[id]; ok {
+			res = append(res, a)
+			delete(byID, id)
+		}
+	}
+	for _, a := range answers {
+		if _, ok := byID[a.ID]; ok {
+			res = append(res, a)
+		}
+	}
+	return res
+}
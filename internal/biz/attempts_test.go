@@ -0,0 +1,91 @@
+package biz
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func answersOf(ids string, pinned ...int) []Answer {
+	answers := make([]Answer, 0, len(ids))
+	for _, id := range ids {
+		answers = append(answers, Answer{ID: string(id)})
+	}
+	for _, i := range pinned {
+		answers[i].Pinned = true
+	}
+	return answers
+}
+
+func TestShuffleAnswers(t *testing.T) {
+	tests := []struct {
+		name    string
+		answers []Answer
+	}{
+		{"none", nil},
+		{"one", answersOf("a")},
+		{"free", answersOf("abcdef")},
+		{"first pinned", answersOf("abcdef", 0)},
+		{"last two pinned", answersOf("abcdef", 4, 5)},
+		{"all pinned", answersOf("abcd", 0, 1, 2, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(0); seed < 20; seed++ {
+				got := shuffleAnswers(rand.New(rand.NewSource(seed)), tt.answers)
+				again := shuffleAnswers(rand.New(rand.NewSource(seed)), tt.answers)
+				if !slices.Equal(got, again) {
+					t.Fatalf("seed %d: %v, then %v", seed, got, again)
+				}
+				if len(got) != len(tt.answers) {
+					t.Fatalf("seed %d: %d IDs for %d answers", seed, len(got), len(tt.answers))
+				}
+				sorted := slices.Clone(got)
+				slices.Sort(sorted)
+				for i, a := range tt.answers {
+					if a.Pinned && got[i] != a.ID {
+						t.Errorf("seed %d: pinned %s moved to %s", seed, a.ID, got[i])
+					}
+					if _, found := slices.BinarySearch(sorted, a.ID); !found {
+						t.Errorf("seed %d: %s missing from %v", seed, a.ID, got)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestShuffleAnswersVariesWithSeed(t *testing.T) {
+	answers := answersOf("abcdef", 2)
+	first := shuffleAnswers(rand.New(rand.NewSource(1)), answers)
+	for seed := int64(2); seed < 50; seed++ {
+		if !slices.Equal(first, shuffleAnswers(rand.New(rand.NewSource(seed)), answers)) {
+			return
+		}
+	}
+	t.Errorf("49 seeds all gave %v", first)
+}
+
+func TestOrderAnswers(t *testing.T) {
+	tests := []struct {
+		name  string
+		order []string
+		want  string
+	}{
+		{"as ordered", []string{"c", "a", "b"}, "cab"},
+		{"added since", []string{"b", "a"}, "bac"},
+		{"deleted since", []string{"x", "c", "b", "a"}, "cba"},
+		{"no order", nil, "abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			for _, a := range orderAnswers(answersOf("abc"), tt.order) {
+				got += a.ID
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
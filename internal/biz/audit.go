@@ -0,0 +1,250 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	AuditCreate  = "create"
+	AuditUpdate  = "update"
+	AuditDelete  = "delete"
+	AuditPublish = "publish"
+
+	AuditEntityQuiz     = "quiz"
+	AuditEntityQuestion = "question"
+)
+
+// AuditEntry records one mutation. Entries are only ever appended.
+type AuditEntry struct {
+	ID      string
+	ActorID string
+	// OwnerID is the author of the quiz the entity belongs to, who may read
+	// the entry along with the actor.
+	OwnerID    string
+	Action     string
+	EntityType string
+	EntityID   string
+	At         time.Time
+	TraceID    string
+	Diff       json.RawMessage
+}
+
+// AuditFilter narrows entries down to one entity and/or actor. VisibleTo, when
+// set, keeps only the entries that user made or owns.
+type AuditFilter struct {
+	EntityType string
+	EntityID   string
+	ActorID    string
+	VisibleTo  string
+}
+
+type AuditRepo interface {
+	Add(ctx context.Context, e *AuditEntry) error
+	List(ctx context.Context, filter *AuditFilter, pagination *Pagination) ([]*AuditEntry, error)
+}
+
+type actorKey struct{}
+
+// WithActor returns a context carrying the user making the request.
+func WithActor(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, actorKey{}, userID)
+}
+
+// ActorFromContext returns the user making the request, or "" when anonymous.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// audit appends an entry for a change of entity from before to after within
+// ctx's transaction. before is nil for creations and after for deletions.
+func audit(ctx context.Context, repo AuditRepo, action string, entityType string, entityID string, ownerID string, before any, after any) error {
+	// an entry nobody can trace back to its entity is worse than none; failing
+	// rolls the change back with it
+	if entityID == "" {
+		return pb.ErrorInternal("audit entry for %s has no entity ID", entityType)
+	}
+	diff, err := auditDiff(before, after)
+	if err != nil {
+		return err
+	}
+	e := &AuditEntry{
+		ActorID:    ActorFromContext(ctx),
+		OwnerID:    ownerID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		At:         time.Now().UTC(),
+		Diff:       diff,
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		e.TraceID = sc.TraceID().String()
+	}
+	return repo.Add(ctx, e)
+}
+
+type auditChange struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// auditDiff maps every top-level JSON field that differs between before and
+// after to its two values.
+func auditDiff(before any, after any) (json.RawMessage, error) {
+	b, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+	null := json.RawMessage("null")
+	diff := make(map[string]auditChange)
+	for k, bv := range b {
+		av, ok := a[k]
+		if !ok {
+			av = null
+		}
+		if !bytes.Equal(bv, av) {
+			diff[k] = auditChange{Before: bv, After: av}
+		}
+	}
+	for k, av := range a {
+		if _, ok := b[k]; !ok && !bytes.Equal(av, null) {
+			diff[k] = auditChange{Before: null, After: av}
+		}
+	}
+	return json.Marshal(diff)
+}
+
+func auditFields(v any) (map[string]json.RawMessage, error) {
+	fields := make(map[string]json.RawMessage)
+	if v == nil {
+		return fields, nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+type auditAnswer struct {
+	ID                      string        `json:"id"`
+	Text                    string        `json:"text"`
+	TextTranslations        Translations  `json:"text_translations,omitempty"`
+	Pinned                  bool          `json:"pinned"`
+	IsCorrect               bool          `json:"is_correct"`
+	Explanation             string        `json:"explanation"`
+	ExplanationTranslations Translations  `json:"explanation_translations,omitempty"`
+	Media                   []Attachment  `json:"media,omitempty"`
+	Format                  ContentFormat `json:"format,omitempty"`
+	ExplanationFormat       ContentFormat `json:"explanation_format,omitempty"`
+}
+
+type auditQuestion struct {
+	QuizID     string        `json:"quiz_id"`
+	Question   string        `json:"question"`
+	Difficulty uint64        `json:"difficulty"`
+	Order      float64       `json:"order"`
+	Hint       string        `json:"hint"`
+	Tags       []string      `json:"tags"`
+	Answers    []auditAnswer `json:"answers"`
+	Media      []Attachment  `json:"media,omitempty"`
+	Format     ContentFormat `json:"format,omitempty"`
+
+	QuestionTranslations Translations `json:"question_translations,omitempty"`
+	HintTranslations     Translations `json:"hint_translations,omitempty"`
+}
+
+// questionAudit snapshots q including the answer key, which the JSON encoding
+// of Question leaves out.
+func questionAudit(q *Question) *auditQuestion {
+	if q == nil {
+		return nil
+	}
+	answers := make([]auditAnswer, 0, len(q.Answers))
+	for _, a := range q.Answers {
+		answers = append(answers, auditAnswer{
+			ID:                      a.ID,
+			Text:                    a.Text,
+			TextTranslations:        a.TextTranslations,
+			Pinned:                  a.Pinned,
+			IsCorrect:               a.isCorrect,
+			Explanation:             a.explanation,
+			ExplanationTranslations: a.explanationTranslations,
+			Media:                   a.Media,
+			Format:                  a.Format,
+			ExplanationFormat:       a.ExplanationFormat,
+		})
+	}
+	return &auditQuestion{
+		QuizID:     q.QuizID,
+		Question:   q.Question,
+		Difficulty: q.Difficulty,
+		Order:      q.Order,
+		Hint:       q.Hint,
+		Tags:       q.Tags,
+		Answers:    answers,
+		Media:      q.Media,
+		Format:     q.Format,
+
+		QuestionTranslations: q.QuestionTranslations,
+		HintTranslations:     q.HintTranslations,
+	}
+}
+
+type AuditUsecase struct {
+	repo   AuditRepo
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewAuditUsecase(repo AuditRepo, logger log.Logger, tracer trace.Tracer) *AuditUsecase {
+	return &AuditUsecase{
+		repo:   repo,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+// ListEntries returns entries matching filter that userID made or owns.
+func (u *AuditUsecase) ListEntries(ctx context.Context, filter *AuditFilter, userID string, pagination *Pagination) ([]*AuditEntry, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.AuditUsecase.ListEntries")
+	defer span.End()
+
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to read the audit log")
+	}
+	if filter.EntityID != "" && filter.EntityType == "" {
+		return nil, pb.ErrorInvalidArgument("entity_id needs an entity_type")
+	}
+	switch filter.EntityType {
+	case "", AuditEntityQuiz, AuditEntityQuestion:
+	default:
+		return nil, pb.ErrorInvalidArgument("unknown entity type %s", filter.EntityType)
+	}
+	if filter.EntityID == "" && filter.ActorID == "" {
+		filter.ActorID = userID
+	}
+	filter.VisibleTo = userID
+
+	res, err := u.repo.List(ctx, filter, pagination)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
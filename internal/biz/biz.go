@@ -3,4 +3,26 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var BizProviderSet = wire.NewSet(NewQuizUsecase, NewQuestionUsecase)
+var BizProviderSet = wire.NewSet(
+	NewQuizUsecase,
+	NewQuestionUsecase,
+	NewProductsUsecase,
+	NewPremiumGate,
+	NewEntitlementsUsecase,
+	NewAttemptsUsecase,
+	NewResultsUsecase,
+	NewLeaderboardUsecase,
+	NewRoomsUsecase,
+	NewEventRelay,
+	NewWebhooksUsecase,
+	NewAuditUsecase,
+	NewCollaboratorsUsecase,
+	NewGroupsUsecase,
+	NewAssignmentsUsecase,
+	NewTranslationsUsecase,
+	NewMediaUsecase,
+	NewRateLimiter,
+	NewAdmins,
+	NewHealthUsecase,
+	wire.Bind(new(ProductOwnership), new(*EntitlementsUsecase)),
+)
@@ -0,0 +1,221 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type CollaboratorRole int32
+
+const (
+	CollaboratorViewer CollaboratorRole = iota
+	CollaboratorEditor
+)
+
+// Collaborator is a user a quiz author shared the quiz with. The role only
+// takes effect once the user accepts the invitation.
+type Collaborator struct {
+	QuizID     string
+	UserID     string
+	Role       CollaboratorRole
+	InvitedBy  string
+	InvitedAt  time.Time
+	AcceptedAt *time.Time
+}
+
+func (c *Collaborator) Accepted() bool {
+	return c.AcceptedAt != nil
+}
+
+type CollaboratorsRepo interface {
+	// Invite stores an invitation. Inviting a user again changes their role
+	// but keeps whether they accepted.
+	Invite(ctx context.Context, c *Collaborator) (*Collaborator, error)
+	Get(ctx context.Context, quizID string, userID string) (*Collaborator, error)
+	Accept(ctx context.Context, quizID string, userID string, at time.Time) (*Collaborator, error)
+	List(ctx context.Context, quizID string, pagination *Pagination) ([]*Collaborator, error)
+	Remove(ctx context.Context, quizID string, userID string) (*Collaborator, error)
+	// RemoveAll drops every collaborator of a deleted quiz.
+	RemoveAll(ctx context.Context, quizID string) error
+}
+
+// collaboratorRole returns the role userID accepted on quizID. ok is false
+// when they were never invited or have not accepted yet.
+func collaboratorRole(ctx context.Context, repo CollaboratorsRepo, quizID string, userID string) (role CollaboratorRole, ok bool, err error) {
+	c, err := repo.Get(ctx, quizID, userID)
+	if errors.IsNotFound(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return c.Role, c.Accepted(), nil
+}
+
+// authorizeEdit checks that userID may change quiz and its questions, which
+// its author and the editors who accepted may.
+func authorizeEdit(ctx context.Context, repo CollaboratorsRepo, quiz *Quiz, userID string) error {
+	if userID == "" {
+		return pb.ErrorUnauthorized("sign in to edit a quiz")
+	}
+	if userID == quiz.UserID {
+		return nil
+	}
+	role, ok, err := collaboratorRole(ctx, repo, quiz.ID, userID)
+	if err != nil {
+		return err
+	}
+	if !ok || role != CollaboratorEditor {
+		return pb.ErrorForbidden("only the quiz author and its editors can change it")
+	}
+	return nil
+}
+
+type CollaboratorsUsecase struct {
+	repo    CollaboratorsRepo
+	quizzes QuizRepo
+	log     *log.Helper
+	tracer  trace.Tracer
+}
+
+func NewCollaboratorsUsecase(repo CollaboratorsRepo, quizzes QuizRepo, logger log.Logger, tracer trace.Tracer) *CollaboratorsUsecase {
+	return &CollaboratorsUsecase{
+		repo:    repo,
+		quizzes: quizzes,
+		log:     log.NewHelper(logger),
+		tracer:  tracer,
+	}
+}
+
+// Invite lets the author of quizID invite userID with role.
+func (u *CollaboratorsUsecase) Invite(ctx context.Context, quizID string, userID string, role CollaboratorRole, authorID string) (*Collaborator, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.CollaboratorsUsecase.Invite")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", quizID), attribute.String("user_id", userID))
+
+	quiz, err := u.author(ctx, quizID, authorID)
+	if err != nil {
+		return nil, err
+	}
+	if userID == quiz.UserID {
+		return nil, pb.ErrorInvalidArgument("the author cannot be invited to their own quiz")
+	}
+	if role != CollaboratorViewer && role != CollaboratorEditor {
+		return nil, pb.ErrorInvalidArgument("unknown role %d", role)
+	}
+	res, err := u.repo.Invite(ctx, &Collaborator{
+		QuizID:    quizID,
+		UserID:    userID,
+		Role:      role,
+		InvitedBy: authorID,
+		InvitedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// Accept accepts the invitation of userID to quizID. Accepting twice is a no-op.
+func (u *CollaboratorsUsecase) Accept(ctx context.Context, quizID string, userID string) (*Collaborator, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.CollaboratorsUsecase.Accept")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", quizID), attribute.String("user_id", userID))
+
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to accept an invitation")
+	}
+	c, err := u.repo.Get(ctx, quizID, userID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if c.Accepted() {
+		return c, nil
+	}
+	res, err := u.repo.Accept(ctx, quizID, userID, time.Now().UTC())
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// List returns the collaborators of quizID to its author and collaborators.
+func (u *CollaboratorsUsecase) List(ctx context.Context, quizID string, userID string, pagination *Pagination) ([]*Collaborator, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.CollaboratorsUsecase.List")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", quizID))
+
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to see collaborators")
+	}
+	quiz, err := u.quizzes.GetByID(ctx, quizID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if userID != quiz.UserID {
+		_, ok, err := collaboratorRole(ctx, u.repo, quizID, userID)
+		if err != nil {
+			u.log.Warn(err)
+			return nil, err
+		}
+		if !ok {
+			return nil, pb.ErrorForbidden("only the quiz author and its collaborators can see them")
+		}
+	}
+	res, err := u.repo.List(ctx, quizID, pagination)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// Remove revokes the access of userID to quizID. The author may remove anyone,
+// collaborators only themselves.
+func (u *CollaboratorsUsecase) Remove(ctx context.Context, quizID string, userID string, callerID string) (*Collaborator, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.CollaboratorsUsecase.Remove")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", quizID), attribute.String("user_id", userID))
+
+	if callerID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to remove a collaborator")
+	}
+	if callerID != userID {
+		if _, err := u.author(ctx, quizID, callerID); err != nil {
+			return nil, err
+		}
+	}
+	res, err := u.repo.Remove(ctx, quizID, userID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// author returns quizID after checking userID wrote it.
+func (u *CollaboratorsUsecase) author(ctx context.Context, quizID string, userID string) (*Quiz, error) {
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to manage collaborators")
+	}
+	quiz, err := u.quizzes.GetByID(ctx, quizID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if quiz.UserID != userID {
+		return nil, pb.ErrorForbidden("only the quiz author can manage its collaborators")
+	}
+	return quiz, nil
+}
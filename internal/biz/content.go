@@ -0,0 +1,249 @@
+package biz
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	gmhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// ContentFormat says how a text is written.
+type ContentFormat int32
+
+const (
+	FormatPlain ContentFormat = iota
+	// FormatMarkdown is CommonMark with the GitHub extensions and LaTeX math
+	// between $ or $$ delimiters.
+	FormatMarkdown
+)
+
+var (
+	// markdown passes raw HTML through; it has been sanitised on the way in
+	// and everything rendered goes through htmlPolicy on the way out.
+	markdown = goldmark.New(
+		goldmark.WithExtensions(extension.GFM, mathExtension{}),
+		goldmark.WithRendererOptions(gmhtml.WithUnsafe()),
+	)
+	htmlPolicy = newHTMLPolicy()
+)
+
+// newHTMLPolicy allows the HTML user generated content may safely contain,
+// plus the spans math is rendered into for a client-side typesetter.
+func newHTMLPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^math (inline|display)$`)).OnElements("span")
+	return p
+}
+
+// Sanitize strips dangerous HTML from s. Raw HTML in Markdown is cleaned in
+// place, leaving the rest of the source as written. Plain text needs nothing;
+// it is escaped whenever it is rendered.
+func (f ContentFormat) Sanitize(s string) string {
+	if f != FormatMarkdown || !strings.Contains(s, "<") {
+		return s
+	}
+	source := []byte(s)
+	type span struct{ start, stop int }
+	var spans []span
+	doc := markdown.Parser().Parse(text.NewReader(source))
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		var segs *text.Segments
+		closure := -1
+		switch n := n.(type) {
+		case *ast.RawHTML:
+			segs = n.Segments
+		case *ast.HTMLBlock:
+			segs = n.Lines()
+			if n.HasClosure() {
+				closure = n.ClosureLine.Stop
+			}
+		default:
+			return ast.WalkContinue, nil
+		}
+		if segs.Len() > 0 {
+			sp := span{segs.At(0).Start, segs.At(segs.Len() - 1).Stop}
+			if closure > sp.stop {
+				sp.stop = closure
+			}
+			spans = append(spans, sp)
+		}
+		return ast.WalkSkipChildren, nil
+	})
+	if len(spans) == 0 {
+		return s
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	var b strings.Builder
+	last := 0
+	for _, sp := range spans {
+		if sp.start < last {
+			continue
+		}
+		b.Write(source[last:sp.start])
+		b.WriteString(htmlPolicy.Sanitize(string(source[sp.start:sp.stop])))
+		last = sp.stop
+	}
+	b.Write(source[last:])
+	return b.String()
+}
+
+// Render returns s as HTML that is safe to embed in a page.
+func (f ContentFormat) Render(s string) string {
+	if f != FormatMarkdown {
+		return strings.ReplaceAll(html.EscapeString(s), "\n", "<br>\n")
+	}
+	var buf bytes.Buffer
+	if err := markdown.Convert([]byte(s), &buf); err != nil {
+		return html.EscapeString(s)
+	}
+	return strings.TrimSpace(htmlPolicy.Sanitize(buf.String()))
+}
+
+// sanitizeQuestion cleans every text of q, in every locale, for its format.
+func sanitizeQuestion(q *Question) {
+	q.Question = q.Format.Sanitize(q.Question)
+	q.Hint = q.Format.Sanitize(q.Hint)
+	q.QuestionTranslations = q.QuestionTranslations.sanitize(q.Format)
+	q.HintTranslations = q.HintTranslations.sanitize(q.Format)
+	for i := range q.Answers {
+		a := &q.Answers[i]
+		a.Text = a.Format.Sanitize(a.Text)
+		a.TextTranslations = a.TextTranslations.sanitize(a.Format)
+		a.explanation = a.ExplanationFormat.Sanitize(a.explanation)
+		a.explanationTranslations = a.explanationTranslations.sanitize(a.ExplanationFormat)
+	}
+}
+
+// sanitize returns a cleaned copy of t, leaving t as it was.
+func (t Translations) sanitize(f ContentFormat) Translations {
+	if t == nil {
+		return nil
+	}
+	res := make(Translations, len(t))
+	for locale, s := range t {
+		res[locale] = f.Sanitize(s)
+	}
+	return res
+}
+
+var kindMath = ast.NewNodeKind("Math")
+
+// mathNode holds the TeX source of a formula. It is rendered escaped inside
+// a span for a client-side typesetter such as KaTeX or MathJax to pick up.
+type mathNode struct {
+	ast.BaseInline
+	display bool
+	tex     []byte
+}
+
+func (n *mathNode) Kind() ast.NodeKind {
+	return kindMath
+}
+
+func (n *mathNode) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"TeX": string(n.tex)}, nil)
+}
+
+type mathExtension struct{}
+
+func (mathExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(util.Prioritized(mathParser{}, 500)))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(util.Prioritized(mathRenderer{}, 500)))
+}
+
+// mathParser reads $inline$ and $$display$$ math, following the pandoc rules
+// so that prices such as "$5 and $10" stay text: an inline opening $ must not
+// be followed by a space, and its closing $ must not follow a space nor be
+// followed by a digit. Only display math may span lines.
+type mathParser struct{}
+
+func (mathParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+func (mathParser) Parse(_ ast.Node, block text.Reader, _ parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+	delim := 1
+	if len(line) > 1 && line[1] == '$' {
+		delim = 2
+	}
+	if delim == 1 && (len(line) < 2 || util.IsSpace(line[1])) {
+		return nil
+	}
+	savedLine, savedPos := block.Position()
+	block.Advance(delim)
+	var tex []byte
+	for {
+		line, _ := block.PeekLine()
+		if line == nil {
+			break
+		}
+		if end := closingDollar(line, delim); end >= 0 {
+			tex = append(tex, line[:end]...)
+			block.Advance(end + delim)
+			if len(bytes.TrimSpace(tex)) == 0 {
+				break
+			}
+			return &mathNode{display: delim == 2, tex: tex}
+		}
+		if delim == 1 {
+			break
+		}
+		tex = append(tex, line...)
+		block.AdvanceLine()
+	}
+	block.SetPosition(savedLine, savedPos)
+	return nil
+}
+
+// closingDollar returns where the closing delimiter is in line, or -1.
+func closingDollar(line []byte, delim int) int {
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\':
+			i++
+		case line[i] != '$':
+		case delim == 2:
+			if i+1 < len(line) && line[i+1] == '$' {
+				return i
+			}
+		case i > 0 && !util.IsSpace(line[i-1]) && (i+1 == len(line) || line[i+1] < '0' || line[i+1] > '9'):
+			return i
+		}
+	}
+	return -1
+}
+
+type mathRenderer struct{}
+
+func (mathRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindMath, func(w util.BufWriter, _ []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		m := n.(*mathNode)
+		open, closing, class := `\(`, `\)`, "math inline"
+		if m.display {
+			open, closing, class = `\[`, `\]`, "math display"
+		}
+		_, _ = w.WriteString(`<span class="` + class + `">` + open)
+		_, _ = w.WriteString(html.EscapeString(string(m.tex)))
+		_, _ = w.WriteString(closing + "</span>")
+		return ast.WalkSkipChildren, nil
+	})
+}
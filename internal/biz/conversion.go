@@ -1,6 +1,11 @@
 package biz
 
-import pb "quiz/api/quizzes/v1"
+import (
+	"math"
+	"time"
+
+	pb "quiz/api/quizzes/v1"
+)
 
 func QuizToPb(q *Quiz) *pb.Quiz {
 	var quiz pb.Quiz
@@ -34,6 +39,43 @@ func QuizToPb(q *Quiz) *pb.Quiz {
 	if q.Metadata != nil {
 		quiz.Metadata = q.Metadata
 	}
+	if q.Premium != nil {
+		quiz.Premium = q.Premium
+	}
+	if q.QuestionIDs != nil {
+		quiz.QuestionIds = q.QuestionIDs
+	}
+	if q.PublishedAt != "" {
+		quiz.PublishedAt = &q.PublishedAt
+	}
+	quiz.DefaultLocale = q.defaultLocale()
+	quiz.Locales = q.AvailableLocales()
+	quiz.Locale = q.Locale
+	if quiz.Locale == "" {
+		quiz.Locale = quiz.DefaultLocale
+	}
+	if q.Adaptive != nil {
+		quiz.Adaptive = &pb.AdaptiveSettings{Length: q.Adaptive.Length}
+		if q.Adaptive.StartDifficulty != nil {
+			difficulty := pb.Difficulty(*q.Adaptive.StartDifficulty)
+			quiz.Adaptive.StartDifficulty = &difficulty
+		}
+	}
+	if q.Shuffle != nil {
+		quiz.Shuffle = &pb.ShuffleSettings{Questions: q.Shuffle.Questions, Answers: q.Shuffle.Answers}
+	}
+	if q.DrawRules != nil {
+		rules := make([]*pb.DrawRule, 0, len(q.DrawRules))
+		for _, r := range q.DrawRules {
+			rule := &pb.DrawRule{Count: r.Count, Tags: r.Tags}
+			if r.Difficulty != nil {
+				difficulty := pb.Difficulty(*r.Difficulty)
+				rule.Difficulty = &difficulty
+			}
+			rules = append(rules, rule)
+		}
+		quiz.DrawRules = rules
+	}
 	var audit pb.Audit
 	if q.CreatedBy != "" {
 		audit.CreatedBy = &q.CreatedBy
@@ -74,10 +116,13 @@ func QuestionToPb(q *Question) *pb.Question {
 	}
 	if q.Answers != nil {
 		var answers []string
+		var choices []*pb.Question_Answer
 		for _, a := range q.Answers {
 			answers = append(answers, a.Text)
+			choices = append(choices, &pb.Question_Answer{ID: a.ID, Text: a.Text, Media: attachmentsToPb(a.Media), Format: pb.ContentFormat(a.Format)})
 		}
 		question.Answers = answers
+		question.Choices = choices
 	}
 	if q.Difficulty != 0 {
 		question.Difficulty = pb.Difficulty(q.Difficulty)
@@ -85,6 +130,16 @@ func QuestionToPb(q *Question) *pb.Question {
 	if q.Order != 0 {
 		question.Order = float32(q.Order)
 	}
+	if q.Tags != nil {
+		question.Tags = q.Tags
+	}
+	if q.Locale != "" {
+		question.Locale = q.Locale
+	}
+	if q.Media != nil {
+		question.Media = attachmentsToPb(q.Media)
+	}
+	question.Format = pb.ContentFormat(q.Format)
 	var audit pb.Audit
 	if q.CreatedBy != "" {
 		audit.CreatedBy = &q.CreatedBy
@@ -107,3 +162,378 @@ func QuestionToPb(q *Question) *pb.Question {
 	question.Audit = &audit
 	return &question
 }
+
+// QuestionWithHTMLToPb is QuestionToPb with the question, hint and answers
+// also rendered to safe HTML.
+func QuestionWithHTMLToPb(q *Question) *pb.Question {
+	question := QuestionToPb(q)
+	rendered := q.Format.Render(q.Question)
+	question.QuestionHtml = &rendered
+	if q.Hint != "" {
+		hint := q.Format.Render(q.Hint)
+		question.HintHtml = &hint
+	}
+	for i, c := range question.Choices {
+		text := q.Answers[i].Format.Render(q.Answers[i].Text)
+		c.TextHtml = &text
+	}
+	return question
+}
+
+func ProductToPb(p *Product) *pb.Product {
+	var product pb.Product
+	if p.ID != "" {
+		product.Id = p.ID
+	}
+	if p.Name != "" {
+		product.Name = p.Name
+	}
+	if p.Description != "" {
+		product.Description = p.Description
+	}
+	if p.Price != 0 {
+		product.Price = p.Price
+	}
+	if p.Category != "" {
+		product.Category = p.Category
+	}
+	if p.Tags != nil {
+		product.Tags = p.Tags
+	}
+	if p.Attributes != nil {
+		product.Attributes = p.Attributes
+	}
+	if p.Thumbnail != nil && *p.Thumbnail != "" {
+		product.Thumbnail = p.Thumbnail
+	}
+	if p.Images != nil {
+		product.Images = p.Images
+	}
+	if p.QuizIDs != nil {
+		product.QuizIds = p.QuizIDs
+	}
+	return &product
+}
+
+func EntitlementToPb(e *Entitlement) *pb.Entitlement {
+	entitlement := pb.Entitlement{
+		Id:        e.ID,
+		UserId:    e.UserID,
+		ProductId: e.ProductID,
+		Source:    e.Source,
+		GrantedBy: e.GrantedBy,
+		GrantedAt: e.GrantedAt.Format(time.RFC3339),
+		Active:    e.Active(time.Now()),
+	}
+	if e.Reference != "" {
+		entitlement.Reference = &e.Reference
+	}
+	if e.ExpiresAt != nil {
+		expiresAt := e.ExpiresAt.Format(time.RFC3339)
+		entitlement.ExpiresAt = &expiresAt
+	}
+	if e.RevokedAt != nil {
+		revokedAt := e.RevokedAt.Format(time.RFC3339)
+		entitlement.RevokedAt = &revokedAt
+	}
+	return &entitlement
+}
+
+func AttemptToPb(a *Attempt) *pb.Attempt {
+	attempt := pb.Attempt{
+		Id:          a.ID,
+		QuizId:      a.QuizID,
+		UserId:      a.UserID,
+		Seed:        a.Seed,
+		QuestionIds: a.QuestionIDs,
+		Score:       a.Score,
+		StartedAt:   a.StartedAt.Format(time.RFC3339),
+		Adaptive:    a.Adaptive,
+		Ability:     a.Ability,
+	}
+	if a.Ability != nil {
+		level := pb.Difficulty(math.Round(float64(*a.Ability)))
+		attempt.AbilityLevel = &level
+	}
+	if a.AssignmentID != "" {
+		attempt.AssignmentId = &a.AssignmentID
+	}
+	for _, r := range a.Responses {
+		response := &pb.QuestionResponse{
+			QuestionId: r.QuestionID,
+			Score:      r.Score,
+			Correct:    r.Correct,
+		}
+		if r.TimeSpent > 0 {
+			timeSpent := r.TimeSpent.Milliseconds()
+			response.TimeSpentMs = &timeSpent
+		}
+		for _, ans := range r.Answers {
+			response.Answers = append(response.Answers, &pb.UserAnswer{AnswerId: ans.AnswerID, Checked: ans.Checked})
+		}
+		attempt.Responses = append(attempt.Responses, response)
+	}
+	if a.SubmittedAt != nil {
+		submittedAt := a.SubmittedAt.Format(time.RFC3339)
+		attempt.SubmittedAt = &submittedAt
+	}
+	return &attempt
+}
+
+func AttemptSummaryToPb(a *Attempt) *pb.AttemptSummary {
+	summary := pb.AttemptSummary{
+		Id:        a.ID,
+		QuizId:    a.QuizID,
+		UserId:    a.UserID,
+		Score:     a.Score,
+		StartedAt: a.StartedAt.Format(time.RFC3339),
+		Adaptive:  a.Adaptive,
+	}
+	if spent, ok := a.TimeSpent(); ok {
+		submittedAt := a.SubmittedAt.Format(time.RFC3339)
+		seconds := int64(spent.Seconds())
+		summary.SubmittedAt = &submittedAt
+		summary.TimeSpentSeconds = &seconds
+	}
+	return &summary
+}
+
+func LeaderboardEntryToPb(e *LeaderboardEntry) *pb.LeaderboardEntry {
+	return &pb.LeaderboardEntry{
+		Rank:        e.Rank,
+		UserId:      e.UserID,
+		Score:       e.Score,
+		TimeSpentMs: e.TimeSpent.Milliseconds(),
+	}
+}
+
+func QuizStatsToPb(s *QuizStats) *pb.QuizStats {
+	return &pb.QuizStats{
+		QuizId:           s.QuizID,
+		UserId:           s.UserID,
+		Attempts:         s.Attempts,
+		BestScore:        s.BestScore,
+		LatestScore:      s.LatestScore,
+		AverageScore:     s.AverageScore,
+		TimeSpentSeconds: int64(s.TimeSpent.Seconds()),
+		LastAttemptAt:    s.LastAttemptAt.Format(time.RFC3339),
+	}
+}
+
+func QuizAnalyticsToPb(a *QuizAnalytics) *pb.GetQuizAnalyticsResponse {
+	res := &pb.GetQuizAnalyticsResponse{
+		QuizId:   a.QuizID,
+		Attempts: a.Attempts,
+	}
+	for _, q := range a.Questions {
+		question := &pb.QuestionAnalytics{
+			QuestionId:         q.Question.ID,
+			Question:           q.Question.Question,
+			Responses:          q.Responses,
+			PValue:             q.PValue,
+			Discrimination:     q.Discrimination,
+			AverageTimeSeconds: float32(q.AverageTime.Seconds()),
+			Flags:              q.Flags,
+		}
+		for _, ans := range q.Answers {
+			question.Answers = append(question.Answers, &pb.AnswerStats{
+				AnswerId:   ans.Answer.ID,
+				Text:       ans.Answer.Text,
+				IsCorrect:  ans.Answer.IsCorrect(),
+				Chosen:     ans.Chosen,
+				ChoiceRate: ans.ChoiceRate,
+			})
+		}
+		res.Questions = append(res.Questions, question)
+	}
+	return res
+}
+
+// WebhookToPb leaves out the secret, which is only shown once on creation.
+func WebhookToPb(w *Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:        w.ID,
+		Url:       w.URL,
+		Events:    w.Events,
+		CreatedAt: w.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func WebhookDeliveryToPb(d *WebhookDelivery) *pb.WebhookDelivery {
+	delivery := pb.WebhookDelivery{
+		Id:        d.ID,
+		WebhookId: d.WebhookID,
+		EventId:   d.EventID,
+		EventType: d.EventType,
+		Status:    pb.WebhookDeliveryStatus(d.Status),
+		Attempts:  d.Attempts,
+		CreatedAt: d.CreatedAt.Format(time.RFC3339),
+	}
+	if d.LastStatusCode != 0 {
+		code := int32(d.LastStatusCode)
+		delivery.LastStatusCode = &code
+	}
+	if d.LastError != "" {
+		delivery.LastError = &d.LastError
+	}
+	if d.NextAttemptAt != nil {
+		next := d.NextAttemptAt.Format(time.RFC3339)
+		delivery.NextAttemptAt = &next
+	}
+	if d.DeliveredAt != nil {
+		delivered := d.DeliveredAt.Format(time.RFC3339)
+		delivery.DeliveredAt = &delivered
+	}
+	return &delivery
+}
+
+func AuditEntryToPb(e *AuditEntry) *pb.AuditEntry {
+	entry := pb.AuditEntry{
+		Id:         e.ID,
+		ActorId:    e.ActorID,
+		Action:     e.Action,
+		EntityType: e.EntityType,
+		EntityId:   e.EntityID,
+		At:         e.At.Format(time.RFC3339),
+		Diff:       string(e.Diff),
+	}
+	if e.TraceID != "" {
+		entry.TraceId = &e.TraceID
+	}
+	return &entry
+}
+
+func CollaboratorToPb(c *Collaborator) *pb.Collaborator {
+	collaborator := pb.Collaborator{
+		QuizId:    c.QuizID,
+		UserId:    c.UserID,
+		Role:      pb.CollaboratorRole(c.Role),
+		InvitedBy: c.InvitedBy,
+		InvitedAt: c.InvitedAt.Format(time.RFC3339),
+	}
+	if c.AcceptedAt != nil {
+		at := c.AcceptedAt.Format(time.RFC3339)
+		collaborator.AcceptedAt = &at
+	}
+	return &collaborator
+}
+
+func GroupToPb(g *Group) *pb.Group {
+	return &pb.Group{
+		Id:          g.ID,
+		OwnerId:     g.OwnerID,
+		Name:        g.Name,
+		Description: g.Description,
+		MemberIds:   g.MemberIDs,
+		CreatedAt:   g.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   g.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func AssignmentToPb(a *Assignment) *pb.Assignment {
+	assignment := pb.Assignment{
+		Id:          a.ID,
+		QuizId:      a.QuizID,
+		GroupId:     a.GroupID,
+		OwnerId:     a.OwnerID,
+		OpensAt:     a.OpensAt.Format(time.RFC3339),
+		MaxAttempts: a.MaxAttempts,
+		CreatedAt:   a.CreatedAt.Format(time.RFC3339),
+	}
+	if a.ClosesAt != nil {
+		closes := a.ClosesAt.Format(time.RFC3339)
+		assignment.ClosesAt = &closes
+	}
+	if a.DueAt != nil {
+		due := a.DueAt.Format(time.RFC3339)
+		assignment.DueAt = &due
+	}
+	return &assignment
+}
+
+func RosterEntryToPb(e *RosterEntry) *pb.RosterEntry {
+	entry := pb.RosterEntry{
+		UserId:    e.UserID,
+		Attempts:  e.Attempts,
+		Completed: e.Completed,
+		BestScore: e.BestScore,
+		Late:      e.Late,
+	}
+	if e.CompletedAt != nil {
+		completed := e.CompletedAt.Format(time.RFC3339)
+		entry.CompletedAt = &completed
+	}
+	return &entry
+}
+
+// answerToPb converts an answer for its editors, key and explanation included.
+func answerToPb(a *Answer) *pb.Answer {
+	explanation := a.explanation
+	return &pb.Answer{
+		Id:                a.ID,
+		Text:              a.Text,
+		IsCorrect:         a.isCorrect,
+		Explanation:       &explanation,
+		Pinned:            a.Pinned,
+		Media:             attachmentsToPb(a.Media),
+		Format:            pb.ContentFormat(a.Format),
+		ExplanationFormat: pb.ContentFormat(a.ExplanationFormat),
+	}
+}
+
+func MediaToPb(m *Media) *pb.MediaFile {
+	return &pb.MediaFile{
+		Id:          m.ID,
+		OwnerId:     m.OwnerID,
+		Filename:    m.Filename,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		Sha256:      m.Checksum,
+		Url:         MediaURL(m.ID),
+		CreatedAt:   m.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func attachmentsToPb(atts []Attachment) []*pb.MediaAttachment {
+	if len(atts) == 0 {
+		return nil
+	}
+	res := make([]*pb.MediaAttachment, 0, len(atts))
+	for _, a := range atts {
+		att := &pb.MediaAttachment{Kind: pb.MediaKind(a.Kind)}
+		if a.MediaID != "" {
+			att.MediaId = &a.MediaID
+			att.Url = MediaURL(a.MediaID)
+		}
+		if a.Code != "" {
+			att.Code = &a.Code
+		}
+		if a.Language != "" {
+			att.Language = &a.Language
+		}
+		if a.Caption != "" {
+			att.Caption = &a.Caption
+		}
+		res = append(res, att)
+	}
+	return res
+}
+
+// AttachmentsFromPb converts attachments from a request. It returns nil for
+// no attachments.
+func AttachmentsFromPb(atts []*pb.MediaAttachment) []Attachment {
+	if len(atts) == 0 {
+		return nil
+	}
+	res := make([]Attachment, 0, len(atts))
+	for _, a := range atts {
+		res = append(res, Attachment{
+			Kind:     MediaKind(a.GetKind()),
+			MediaID:  a.GetMediaId(),
+			Code:     a.GetCode(),
+			Language: a.GetLanguage(),
+			Caption:  a.GetCaption(),
+		})
+	}
+	return res
+}
@@ -0,0 +1,276 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/conf"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	EntitlementSourceAdmin    = "admin"
+	EntitlementSourcePurchase = "purchase"
+
+	PurchaseCompleted = "purchase.completed"
+	PurchaseRefunded  = "purchase.refunded"
+)
+
+var defaultWebhookTolerance = 5 * time.Minute
+
+type Entitlement struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	ProductID string     `json:"product_id"`
+	Source    string     `json:"source"`
+	Reference string     `json:"reference"`
+	GrantedBy string     `json:"granted_by"`
+	GrantedAt time.Time  `json:"granted_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+}
+
+// Active reports whether the entitlement grants access at the given time.
+func (e *Entitlement) Active(now time.Time) bool {
+	if e.RevokedAt != nil {
+		return false
+	}
+	return e.ExpiresAt == nil || e.ExpiresAt.After(now)
+}
+
+// PurchaseNotification is the body of a signed call from the payment provider.
+type PurchaseNotification struct {
+	EventID   string     `json:"event_id"`
+	Type      string     `json:"type"`
+	UserID    string     `json:"user_id"`
+	ProductID string     `json:"product_id"`
+	OrderID   string     `json:"order_id"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type EntitlementsRepo interface {
+	Save(ctx context.Context, e *Entitlement) (*Entitlement, error)
+	GetByID(ctx context.Context, id string) (*Entitlement, error)
+	GetByReference(ctx context.Context, reference string) (*Entitlement, error)
+	ListByUser(ctx context.Context, userID string, activeAt *time.Time, pagination *Pagination) ([]*Entitlement, error)
+	HasActive(ctx context.Context, userID string, productIDs []string, at time.Time) (bool, error)
+	Revoke(ctx context.Context, id string, at time.Time) (*Entitlement, error)
+	SetExpiry(ctx context.Context, id string, expiresAt *time.Time) (*Entitlement, error)
+}
+
+type EntitlementsUsecase struct {
+	repo      EntitlementsRepo
+	products  ProductsRepo
+	admins    *Admins
+	secret    []byte
+	tolerance time.Duration
+	log       *log.Helper
+	tracer    trace.Tracer
+}
+
+var _ ProductOwnership = (*EntitlementsUsecase)(nil)
+
+func NewEntitlementsUsecase(bc *conf.Bootstrap, repo EntitlementsRepo, products ProductsRepo, admins *Admins, logger log.Logger, tracer trace.Tracer) *EntitlementsUsecase {
+	tolerance := defaultWebhookTolerance
+	if t := bc.GetBilling().GetWebhookTolerance(); t != nil {
+		tolerance = t.AsDuration()
+	}
+	return &EntitlementsUsecase{
+		repo:      repo,
+		products:  products,
+		admins:    admins,
+		secret:    []byte(bc.GetBilling().GetWebhookSecret()),
+		tolerance: tolerance,
+		log:       log.NewHelper(logger),
+		tracer:    tracer,
+	}
+}
+
+// Grant gives a user a product on behalf of an admin.
+func (u *EntitlementsUsecase) Grant(ctx context.Context, e *Entitlement) (*Entitlement, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.EntitlementsUsecase.Grant")
+	defer span.End()
+	span.SetAttributes(attribute.String("user_id", e.UserID), attribute.String("product_id", e.ProductID))
+
+	if err := u.admins.Authorize(ctx); err != nil {
+		return nil, err
+	}
+	return u.grant(ctx, e)
+}
+
+func (u *EntitlementsUsecase) grant(ctx context.Context, e *Entitlement) (*Entitlement, error) {
+	if e.UserID == "" || e.ProductID == "" {
+		return nil, pb.ErrorInvalidArgument("user id and product id are required")
+	}
+	if _, err := u.products.GetByID(ctx, e.ProductID); err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if e.Source == "" {
+		e.Source = EntitlementSourceAdmin
+	}
+	e.GrantedAt = time.Now().UTC()
+	if e.ExpiresAt != nil && !e.ExpiresAt.After(e.GrantedAt) {
+		return nil, pb.ErrorInvalidArgument("expiry must be in the future")
+	}
+	res, err := u.repo.Save(ctx, e)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *EntitlementsUsecase) Revoke(ctx context.Context, id string) (*Entitlement, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.EntitlementsUsecase.Revoke")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	if err := u.admins.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.Revoke(ctx, id, time.Now().UTC())
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *EntitlementsUsecase) SetExpiry(ctx context.Context, id string, expiresAt *time.Time) (*Entitlement, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.EntitlementsUsecase.SetExpiry")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	if err := u.admins.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.SetExpiry(ctx, id, expiresAt)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *EntitlementsUsecase) List(ctx context.Context, userID string, activeOnly bool, pagination *Pagination) ([]*Entitlement, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.EntitlementsUsecase.List")
+	defer span.End()
+	span.SetAttributes(attribute.String("user_id", userID))
+
+	if err := u.admins.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	var activeAt *time.Time
+	if activeOnly {
+		now := time.Now().UTC()
+		activeAt = &now
+	}
+	res, err := u.repo.ListByUser(ctx, userID, activeAt, pagination)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// OwnsAny reports whether the user holds an active entitlement to one of the products.
+func (u *EntitlementsUsecase) OwnsAny(ctx context.Context, userID string, productIDs []string) (bool, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.EntitlementsUsecase.OwnsAny")
+	defer span.End()
+	span.SetAttributes(attribute.String("user_id", userID), attribute.StringSlice("product_ids", productIDs))
+
+	if userID == "" || len(productIDs) == 0 {
+		return false, nil
+	}
+	return u.repo.HasActive(ctx, userID, productIDs, time.Now().UTC())
+}
+
+// VerifySignature checks an HMAC-SHA256 signature over "<timestamp>.<body>",
+// rejecting stale timestamps to prevent replays.
+func (u *EntitlementsUsecase) VerifySignature(body []byte, timestamp string, signature string) error {
+	if len(u.secret) == 0 {
+		return pb.ErrorUnavailable("no webhook secret is configured")
+	}
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return pb.ErrorInvalidSignature("timestamp is missing or malformed")
+	}
+	age := time.Since(time.Unix(sec, 0))
+	if age > u.tolerance || age < -u.tolerance {
+		return pb.ErrorInvalidSignature("timestamp is outside the tolerance window")
+	}
+	given, err := hex.DecodeString(signature)
+	if err != nil {
+		return pb.ErrorInvalidSignature("signature is not hex encoded")
+	}
+	mac := hmac.New(sha256.New, u.secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	if !hmac.Equal(given, mac.Sum(nil)) {
+		return pb.ErrorInvalidSignature("signature does not match")
+	}
+	return nil
+}
+
+// HandlePurchase applies a verified purchase notification. Completed purchases
+// grant an entitlement keyed by the order, refunds revoke it. Replayed
+// notifications are acknowledged without side effects.
+func (u *EntitlementsUsecase) HandlePurchase(ctx context.Context, body []byte) (*Entitlement, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.EntitlementsUsecase.HandlePurchase")
+	defer span.End()
+
+	var n PurchaseNotification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, pb.ErrorInvalidArgument("invalid notification: %v", err)
+	}
+	if n.OrderID == "" {
+		return nil, pb.ErrorInvalidArgument("order id is required")
+	}
+	span.SetAttributes(attribute.String("event_id", n.EventID), attribute.String("order_id", n.OrderID), attribute.String("type", n.Type))
+
+	existing, err := u.repo.GetByReference(ctx, n.OrderID)
+	if err != nil && !errors.IsNotFound(err) {
+		u.log.Warn(err)
+		return nil, err
+	}
+
+	switch n.Type {
+	case PurchaseCompleted:
+		if existing != nil {
+			return existing, nil
+		}
+		return u.grant(ctx, &Entitlement{
+			UserID:    n.UserID,
+			ProductID: n.ProductID,
+			Source:    EntitlementSourcePurchase,
+			Reference: n.OrderID,
+			GrantedBy: EntitlementSourcePurchase,
+			ExpiresAt: n.ExpiresAt,
+		})
+	case PurchaseRefunded:
+		if existing == nil {
+			return nil, pb.ErrorEntitlementNotFound("no entitlement for order %s", n.OrderID)
+		}
+		if existing.RevokedAt != nil {
+			return existing, nil
+		}
+		return u.Revoke(ctx, existing.ID)
+	default:
+		return nil, pb.ErrorInvalidArgument("unsupported type %s", n.Type)
+	}
+}
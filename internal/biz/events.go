@@ -0,0 +1,167 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/conf"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	EventQuizCreated      = "quiz.created"
+	EventQuizUpdated      = "quiz.updated"
+	EventQuizPublished    = "quiz.published"
+	EventQuizDeleted      = "quiz.deleted"
+	EventAttemptSubmitted = "attempt.submitted"
+
+	defaultRelayInterval  = time.Second
+	defaultRelayBatchSize = 100
+	// relayLease outlasts publishing a batch, after which another instance
+	// may take over the events of one that died halfway
+	relayLease = 30 * time.Second
+)
+
+// DomainEvent records a change other services may react to. Delivery is at
+// least once, so consumers should deduplicate on ID.
+type DomainEvent struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	AggregateID string          `json:"aggregate_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Data        json.RawMessage `json:"data"`
+}
+
+func NewDomainEvent(eventType string, aggregateID string, data any) (*DomainEvent, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &DomainEvent{
+		ID:          uuid.NewString(),
+		Type:        eventType,
+		AggregateID: aggregateID,
+		OccurredAt:  time.Now().UTC(),
+		Data:        raw,
+	}, nil
+}
+
+// Transaction runs fn so that every repo write made with the context it is
+// given commits or rolls back together.
+type Transaction interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// OutboxRepo stores events in the same transaction as the change they describe
+// until the relay has handed them to the sink.
+type OutboxRepo interface {
+	Add(ctx context.Context, events ...*DomainEvent) error
+	// Claim leases up to limit unpublished events, oldest first, for lease,
+	// so other instances leave them alone while they are being published.
+	Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*DomainEvent, error)
+	MarkPublished(ctx context.Context, ids []string) error
+}
+
+// EventSink is where the relay publishes events.
+type EventSink interface {
+	Publish(ctx context.Context, events []*DomainEvent) error
+}
+
+// record adds an event built from data to the outbox within ctx's transaction.
+func record(ctx context.Context, outbox OutboxRepo, eventType string, aggregateID string, data any) error {
+	if aggregateID == "" {
+		return pb.ErrorInternal("%s event has no aggregate ID", eventType)
+	}
+	e, err := NewDomainEvent(eventType, aggregateID, data)
+	if err != nil {
+		return err
+	}
+	return outbox.Add(ctx, e)
+}
+
+// EventRelay moves events from the outbox to the sink.
+type EventRelay struct {
+	outbox    OutboxRepo
+	sink      EventSink
+	webhooks  *WebhooksUsecase
+	interval  time.Duration
+	batchSize int
+	log       *log.Helper
+	tracer    trace.Tracer
+}
+
+func NewEventRelay(bc *conf.Bootstrap, outbox OutboxRepo, sink EventSink, webhooks *WebhooksUsecase, logger log.Logger, tracer trace.Tracer) *EventRelay {
+	r := &EventRelay{
+		outbox:    outbox,
+		sink:      sink,
+		webhooks:  webhooks,
+		interval:  defaultRelayInterval,
+		batchSize: defaultRelayBatchSize,
+		log:       log.NewHelper(logger),
+		tracer:    tracer,
+	}
+	if i := bc.GetEvents().GetPollInterval(); i != nil {
+		r.interval = i.AsDuration()
+	}
+	if n := bc.GetEvents().GetBatchSize(); n > 0 {
+		r.batchSize = int(n)
+	}
+	return r
+}
+
+// Run relays events until ctx is done. A batch the sink rejects stays in the
+// outbox and is retried once its lease runs out.
+func (r *EventRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		// drain full batches without waiting for the next tick
+		for {
+			n, err := r.relay(ctx)
+			if err != nil {
+				r.log.Warn(err)
+			}
+			if err != nil || n < r.batchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *EventRelay) relay(ctx context.Context) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "biz.EventRelay.relay")
+	defer span.End()
+
+	events, err := r.outbox.Claim(ctx, time.Now().UTC(), relayLease, r.batchSize)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+	span.SetAttributes(attribute.Int("events", len(events)))
+	if err := r.sink.Publish(ctx, events); err != nil {
+		return 0, err
+	}
+	// queuing webhook deliveries is idempotent, so a batch retried after a
+	// failure here does not notify anyone twice
+	if err := r.webhooks.Enqueue(ctx, events); err != nil {
+		return 0, err
+	}
+	ids := make([]string, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+	if err := r.outbox.MarkPublished(ctx, ids); err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
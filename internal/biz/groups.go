@@ -0,0 +1,212 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+// Group is a set of users an instructor assigns quizzes to.
+type Group struct {
+	ID          string    `json:"id"`
+	OwnerID     string    `json:"owner_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	MemberIDs   []string  `json:"member_ids"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// HasMember reports whether userID belongs to the group.
+func (g *Group) HasMember(userID string) bool {
+	return slices.Contains(g.MemberIDs, userID)
+}
+
+type GroupsRepo interface {
+	Save(ctx context.Context, g *Group) (*Group, error)
+	GetByID(ctx context.Context, id string) (*Group, error)
+	// List returns the groups userID owns or belongs to.
+	List(ctx context.Context, userID string, pagination *Pagination) ([]*Group, error)
+	// MemberOf returns the IDs of every group userID belongs to.
+	MemberOf(ctx context.Context, userID string) ([]string, error)
+	// Update stores the name and description of g.
+	Update(ctx context.Context, g *Group) (*Group, error)
+	AddMembers(ctx context.Context, id string, userIDs []string) (*Group, error)
+	RemoveMember(ctx context.Context, id string, userID string) (*Group, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type GroupsUsecase struct {
+	repo        GroupsRepo
+	assignments AssignmentsRepo
+	tx          Transaction
+	log         *log.Helper
+	tracer      trace.Tracer
+}
+
+func NewGroupsUsecase(repo GroupsRepo, assignments AssignmentsRepo, tx Transaction, logger log.Logger, tracer trace.Tracer) *GroupsUsecase {
+	return &GroupsUsecase{
+		repo:        repo,
+		assignments: assignments,
+		tx:          tx,
+		log:         log.NewHelper(logger),
+		tracer:      tracer,
+	}
+}
+
+func (u *GroupsUsecase) CreateGroup(ctx context.Context, g *Group) (*Group, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.GroupsUsecase.CreateGroup")
+	defer span.End()
+
+	if g.OwnerID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to create a group")
+	}
+	now := time.Now().UTC()
+	g.CreatedAt = now
+	g.UpdatedAt = now
+	if g.MemberIDs == nil {
+		g.MemberIDs = []string{}
+	}
+	res, err := u.repo.Save(ctx, g)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetGroup returns a group to its owner and members.
+func (u *GroupsUsecase) GetGroup(ctx context.Context, id string, userID string) (*Group, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.GroupsUsecase.GetGroup")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to see a group")
+	}
+	g, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if g.OwnerID != userID && !g.HasMember(userID) {
+		return nil, pb.ErrorForbidden("only the owner and members of a group can see it")
+	}
+	return g, nil
+}
+
+func (u *GroupsUsecase) ListGroups(ctx context.Context, userID string, pagination *Pagination) ([]*Group, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.GroupsUsecase.ListGroups")
+	defer span.End()
+
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to see your groups")
+	}
+	res, err := u.repo.List(ctx, userID, pagination)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateGroup applies the set name and description of g.
+func (u *GroupsUsecase) UpdateGroup(ctx context.Context, g *Group, userID string) (*Group, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.GroupsUsecase.UpdateGroup")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", g.ID))
+
+	existing, err := u.owned(ctx, g.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if g.Name != "" {
+		existing.Name = g.Name
+	}
+	if g.Description != "" {
+		existing.Description = g.Description
+	}
+	existing.UpdatedAt = time.Now().UTC()
+	res, err := u.repo.Update(ctx, existing)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteGroup deletes a group and the assignments made to it.
+func (u *GroupsUsecase) DeleteGroup(ctx context.Context, id string, userID string) error {
+	ctx, span := u.tracer.Start(ctx, "biz.GroupsUsecase.DeleteGroup")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	if _, err := u.owned(ctx, id, userID); err != nil {
+		return err
+	}
+	err := u.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := u.assignments.DeleteByGroup(ctx, id); err != nil {
+			return err
+		}
+		return u.repo.Delete(ctx, id)
+	})
+	if err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	return nil
+}
+
+func (u *GroupsUsecase) AddMembers(ctx context.Context, id string, userIDs []string, userID string) (*Group, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.GroupsUsecase.AddMembers")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id), attribute.Int("members", len(userIDs)))
+
+	if _, err := u.owned(ctx, id, userID); err != nil {
+		return nil, err
+	}
+	res, err := u.repo.AddMembers(ctx, id, userIDs)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *GroupsUsecase) RemoveMember(ctx context.Context, id string, memberID string, userID string) (*Group, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.GroupsUsecase.RemoveMember")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	if _, err := u.owned(ctx, id, userID); err != nil {
+		return nil, err
+	}
+	res, err := u.repo.RemoveMember(ctx, id, memberID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// owned returns group id after checking userID owns it.
+func (u *GroupsUsecase) owned(ctx context.Context, id string, userID string) (*Group, error) {
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to manage groups")
+	}
+	g, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if g.OwnerID != userID {
+		return nil, pb.ErrorForbidden("only the owner of a group can change it")
+	}
+	return g, nil
+}
@@ -0,0 +1,80 @@
+package biz
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// healthCheckTimeout bounds a readiness check, so that a hanging dependency
+// reports as down instead of holding the probe until it gives up.
+const healthCheckTimeout = 800 * time.Millisecond
+
+// DependencyHealth is the outcome of pinging one dependency.
+type DependencyHealth struct {
+	Name    string
+	Healthy bool
+	Latency time.Duration
+	Error   string
+}
+
+type HealthReport struct {
+	Ready        bool
+	Draining     bool
+	Dependencies []DependencyHealth
+	CheckedAt    time.Time
+}
+
+type HealthRepo interface {
+	// Check pings every configured dependency, reporting those still pending
+	// when ctx is done as down.
+	Check(ctx context.Context) []DependencyHealth
+}
+
+type HealthUsecase struct {
+	repo     HealthRepo
+	draining atomic.Bool
+	log      *log.Helper
+	tracer   trace.Tracer
+}
+
+func NewHealthUsecase(repo HealthRepo, logger log.Logger, tracer trace.Tracer) *HealthUsecase {
+	return &HealthUsecase{
+		repo:   repo,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+// Ready reports whether every dependency answers. An instance shutting down
+// is never ready, so that it stops receiving traffic before it stops serving.
+func (u *HealthUsecase) Ready(ctx context.Context) *HealthReport {
+	ctx, span := u.tracer.Start(ctx, "biz.HealthUsecase.Ready")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	res := &HealthReport{
+		Ready:        !u.draining.Load(),
+		Draining:     u.draining.Load(),
+		Dependencies: u.repo.Check(ctx),
+		CheckedAt:    time.Now().UTC(),
+	}
+	for _, d := range res.Dependencies {
+		if !d.Healthy {
+			u.log.Warnf("dependency %s is down: %s", d.Name, d.Error)
+			res.Ready = false
+		}
+	}
+	span.SetAttributes(attribute.Bool("ready", res.Ready))
+	return res
+}
+
+// Drain marks the instance as shutting down.
+func (u *HealthUsecase) Drain() {
+	u.draining.Store(true)
+}
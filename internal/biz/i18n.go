@@ -0,0 +1,477 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/text/language"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+// DefaultLocale is the locale of quizzes created without one and of bank
+// questions, which belong to no quiz.
+const DefaultLocale = "en"
+
+// Translations maps a locale to the text of one field in it.
+type Translations map[string]string
+
+// text returns the translation for locale, or fallback when there is none.
+func (t Translations) text(locale string, fallback string) string {
+	if s, ok := t[locale]; ok && s != "" {
+		return s
+	}
+	return fallback
+}
+
+// set stores s for locale, removing the translation when s is empty.
+func (t Translations) set(locale string, s string) Translations {
+	if t == nil {
+		t = make(Translations)
+	}
+	if s == "" {
+		delete(t, locale)
+	} else {
+		t[locale] = s
+	}
+	return t
+}
+
+// MissingTranslation names a field of a quiz, question or answer without text
+// in Locale.
+type MissingTranslation struct {
+	Locale     string
+	QuestionID string
+	AnswerID   string
+	Field      string
+}
+
+// AnswerTranslation holds the text of answer AnswerID in one locale. Empty
+// fields remove their translation.
+type AnswerTranslation struct {
+	AnswerID    string
+	Text        string
+	Explanation string
+}
+
+// parseLocale canonicalizes a BCP 47 tag, so that "pt_br" and "pt-BR" name
+// the same locale.
+func parseLocale(field string, s string) (string, error) {
+	tag, err := language.Parse(strings.TrimSpace(s))
+	if err != nil || tag == language.Und {
+		return "", FieldErrors(map[string]string{field: "must be a BCP 47 language tag"})
+	}
+	return tag.String(), nil
+}
+
+// MatchLocale picks the locale in available that best serves accept, a list of
+// preferred locales in Accept-Language syntax. It falls back to the first of
+// available, the default locale.
+func MatchLocale(accept string, available []string) string {
+	if len(available) == 0 {
+		return DefaultLocale
+	}
+	if strings.TrimSpace(accept) == "" || len(available) == 1 {
+		return available[0]
+	}
+	preferred, _, err := language.ParseAcceptLanguage(accept)
+	if err != nil || len(preferred) == 0 {
+		return available[0]
+	}
+	tags := make([]language.Tag, 0, len(available))
+	for _, l := range available {
+		tags = append(tags, language.Make(l))
+	}
+	_, i, confidence := language.NewMatcher(tags).Match(preferred...)
+	if confidence == language.No {
+		return available[0]
+	}
+	return available[i]
+}
+
+// AvailableLocales returns the locales q can be read in, its default one first.
+func (q *Quiz) AvailableLocales() []string {
+	return append([]string{q.defaultLocale()}, q.Locales...)
+}
+
+func (q *Quiz) defaultLocale() string {
+	if q.DefaultLocale == "" {
+		return DefaultLocale
+	}
+	return q.DefaultLocale
+}
+
+// Localize returns a copy of q with its text in locale. Untranslated fields
+// keep the default text.
+func (q *Quiz) Localize(locale string) *Quiz {
+	res := *q
+	res.Locale = locale
+	if locale == q.defaultLocale() {
+		return &res
+	}
+	res.Title = q.TitleTranslations.text(locale, q.Title)
+	res.Description = q.DescriptionTranslations.text(locale, q.Description)
+	return &res
+}
+
+// Localize returns a copy of q with its text and answers in locale, which
+// defaultLocale is the locale of the untranslated text.
+func (q *Question) Localize(locale string, defaultLocale string) *Question {
+	res := *q
+	res.Locale = locale
+	if locale == defaultLocale {
+		return &res
+	}
+	res.Question = q.QuestionTranslations.text(locale, q.Question)
+	res.Hint = q.HintTranslations.text(locale, q.Hint)
+	res.Answers = make([]Answer, 0, len(q.Answers))
+	for _, a := range q.Answers {
+		a.Text = a.TextTranslations.text(locale, a.Text)
+		a.explanation = a.explanationTranslations.text(locale, a.explanation)
+		res.Answers = append(res.Answers, a)
+	}
+	return &res
+}
+
+// localizeQuestions puts questions of quiz in the locale best matching accept.
+// Bank questions, without a quiz, are matched one by one against the locales
+// they have text in.
+func localizeQuestions(quiz *Quiz, accept string, questions []*Question) []*Question {
+	res := make([]*Question, 0, len(questions))
+	if quiz != nil {
+		locale := MatchLocale(accept, quiz.AvailableLocales())
+		for _, q := range questions {
+			res = append(res, q.Localize(locale, quiz.defaultLocale()))
+		}
+		return res
+	}
+	for _, q := range questions {
+		available := []string{DefaultLocale}
+		for l := range q.QuestionTranslations {
+			available = append(available, l)
+		}
+		sort.Strings(available[1:])
+		res = append(res, q.Localize(MatchLocale(accept, available), DefaultLocale))
+	}
+	return res
+}
+
+type TranslationsUsecase struct {
+	quizzes       QuizRepo
+	questions     QuestionsRepo
+	collaborators CollaboratorsRepo
+	tx            Transaction
+	audit         AuditRepo
+	log           *log.Helper
+	tracer        trace.Tracer
+}
+
+func NewTranslationsUsecase(quizzes QuizRepo, questions QuestionsRepo, collaborators CollaboratorsRepo, tx Transaction, audit AuditRepo, logger log.Logger, tracer trace.Tracer) *TranslationsUsecase {
+	return &TranslationsUsecase{
+		quizzes:       quizzes,
+		questions:     questions,
+		collaborators: collaborators,
+		tx:            tx,
+		audit:         audit,
+		log:           log.NewHelper(logger),
+		tracer:        tracer,
+	}
+}
+
+// TranslateQuiz sets the title and description of quiz id in locale.
+func (u *TranslationsUsecase) TranslateQuiz(ctx context.Context, id string, locale string, title string, description string) (*Quiz, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.TranslationsUsecase.TranslateQuiz")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", id), attribute.String("locale", locale))
+
+	quiz, locale, err := u.editable(ctx, id, locale)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]string)
+	if n := utf8.RuneCountInString(strings.TrimSpace(title)); n == 0 {
+		fields["title"] = "must not be empty"
+	} else if n > MaxQuizTitleLength {
+		fields["title"] = "must be at most 200 characters"
+	}
+	if len(fields) > 0 {
+		return nil, FieldErrors(fields)
+	}
+
+	before := *quiz
+	quiz.TitleTranslations = cloneTranslations(quiz.TitleTranslations).set(locale, title)
+	quiz.DescriptionTranslations = cloneTranslations(quiz.DescriptionTranslations).set(locale, description)
+	if !slices.Contains(quiz.Locales, locale) {
+		quiz.Locales = append(slices.Clone(quiz.Locales), locale)
+	}
+	return u.updateQuiz(ctx, &before, quiz)
+}
+
+// TranslateQuestion sets the text of question id and of the given answers in
+// locale, adding locale to the quiz of the question when it is new.
+func (u *TranslationsUsecase) TranslateQuestion(ctx context.Context, id string, locale string, text string, hint string, answers []AnswerTranslation) (*Question, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.TranslationsUsecase.TranslateQuestion")
+	defer span.End()
+	span.SetAttributes(attribute.String("question_id", id), attribute.String("locale", locale))
+
+	q, err := u.questions.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	var quiz *Quiz
+	if q.QuizID != "" {
+		if quiz, locale, err = u.editable(ctx, q.QuizID, locale); err != nil {
+			return nil, err
+		}
+	} else {
+		if locale, err = parseLocale("locale", locale); err != nil {
+			return nil, err
+		}
+		if locale == DefaultLocale {
+			return nil, FieldErrors(map[string]string{"locale": "is the default locale of the question"})
+		}
+	}
+
+	fields := make(map[string]string)
+	if n := utf8.RuneCountInString(strings.TrimSpace(text)); n == 0 {
+		fields["question"] = "must not be empty"
+	} else if n > MaxQuestionTextLength {
+		fields["question"] = "must be at most 1000 characters"
+	}
+	byID := make(map[string]int, len(q.Answers))
+	for i, a := range q.Answers {
+		byID[a.ID] = i
+	}
+	for i, t := range answers {
+		if _, ok := byID[t.AnswerID]; !ok {
+			fields[fmt.Sprintf("answers[%d].answer_id", i)] = "is not an answer of the question"
+		}
+		if utf8.RuneCountInString(t.Text) > MaxAnswerTextLength {
+			fields[fmt.Sprintf("answers[%d].text", i)] = "must be at most 500 characters"
+		}
+	}
+	if len(fields) > 0 {
+		return nil, FieldErrors(fields)
+	}
+
+	before := questionAudit(q)
+	q.QuestionTranslations = cloneTranslations(q.QuestionTranslations).set(locale, text)
+	q.HintTranslations = cloneTranslations(q.HintTranslations).set(locale, hint)
+	q.Answers = slices.Clone(q.Answers)
+	for _, t := range answers {
+		a := &q.Answers[byID[t.AnswerID]]
+		a.TextTranslations = cloneTranslations(a.TextTranslations).set(locale, t.Text)
+		a.explanationTranslations = cloneTranslations(a.explanationTranslations).set(locale, t.Explanation)
+	}
+	sanitizeQuestion(q)
+	q.UpdatedBy = ActorFromContext(ctx)
+
+	var res *Question
+	err = u.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = u.questions.Update(ctx, q); err != nil {
+			return err
+		}
+		owner := ""
+		if quiz != nil {
+			owner = quiz.UserID
+			if !slices.Contains(quiz.Locales, locale) {
+				quizBefore := *quiz
+				quiz.Locales = append(slices.Clone(quiz.Locales), locale)
+				if _, err := u.quizzes.Update(ctx, quiz); err != nil {
+					return err
+				}
+				if err := audit(ctx, u.audit, AuditUpdate, AuditEntityQuiz, quiz.ID, owner, &quizBefore, quiz); err != nil {
+					return err
+				}
+			}
+		}
+		return audit(ctx, u.audit, AuditUpdate, AuditEntityQuestion, res.ID, owner, before, questionAudit(res))
+	})
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if quiz != nil {
+		return res.Localize(locale, quiz.defaultLocale()), nil
+	}
+	return res.Localize(locale, DefaultLocale), nil
+}
+
+// RemoveLocale drops every translation to locale from quiz id and its questions.
+func (u *TranslationsUsecase) RemoveLocale(ctx context.Context, id string, locale string) (*Quiz, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.TranslationsUsecase.RemoveLocale")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", id), attribute.String("locale", locale))
+
+	quiz, locale, err := u.editable(ctx, id, locale)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(quiz.Locales, locale) {
+		return nil, pb.ErrorInvalidArgument("quiz is not translated to %s", locale)
+	}
+	questions, err := u.quizQuestions(ctx, quiz.ID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+
+	before := *quiz
+	quiz.Locales = slices.DeleteFunc(slices.Clone(quiz.Locales), func(l string) bool { return l == locale })
+	quiz.TitleTranslations = cloneTranslations(quiz.TitleTranslations).set(locale, "")
+	quiz.DescriptionTranslations = cloneTranslations(quiz.DescriptionTranslations).set(locale, "")
+
+	var res *Quiz
+	err = u.tx.InTx(ctx, func(ctx context.Context) error {
+		for _, q := range questions {
+			before := questionAudit(q)
+			q.QuestionTranslations = cloneTranslations(q.QuestionTranslations).set(locale, "")
+			q.HintTranslations = cloneTranslations(q.HintTranslations).set(locale, "")
+			q.Answers = slices.Clone(q.Answers)
+			for i := range q.Answers {
+				a := &q.Answers[i]
+				a.TextTranslations = cloneTranslations(a.TextTranslations).set(locale, "")
+				a.explanationTranslations = cloneTranslations(a.explanationTranslations).set(locale, "")
+			}
+			updated, err := u.questions.Update(ctx, q)
+			if err != nil {
+				return err
+			}
+			if err := audit(ctx, u.audit, AuditUpdate, AuditEntityQuestion, updated.ID, quiz.UserID, before, questionAudit(updated)); err != nil {
+				return err
+			}
+		}
+		var err error
+		if res, err = u.quizzes.Update(ctx, quiz); err != nil {
+			return err
+		}
+		return audit(ctx, u.audit, AuditUpdate, AuditEntityQuiz, res.ID, res.UserID, &before, res)
+	})
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListMissingTranslations lists the fields of quiz id and its questions with no
+// text in locale, or in any locale of the quiz when locale is empty. Optional
+// fields left empty in the default locale aren't reported.
+func (u *TranslationsUsecase) ListMissingTranslations(ctx context.Context, id string, locale string) ([]*MissingTranslation, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.TranslationsUsecase.ListMissingTranslations")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", id), attribute.String("locale", locale))
+
+	quiz, err := u.quizzes.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if err := authorizeEdit(ctx, u.collaborators, quiz, ActorFromContext(ctx)); err != nil {
+		return nil, err
+	}
+	locales := quiz.Locales
+	if locale != "" {
+		if locale, err = parseLocale("locale", locale); err != nil {
+			return nil, err
+		}
+		locales = []string{locale}
+	}
+	questions, err := u.quizQuestions(ctx, quiz.ID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+
+	var res []*MissingTranslation
+	for _, l := range locales {
+		if l == quiz.defaultLocale() {
+			continue
+		}
+		missing := func(t Translations, base string, questionID string, answerID string, field string) {
+			if base != "" && t[l] == "" {
+				res = append(res, &MissingTranslation{Locale: l, QuestionID: questionID, AnswerID: answerID, Field: field})
+			}
+		}
+		missing(quiz.TitleTranslations, quiz.Title, "", "", "title")
+		missing(quiz.DescriptionTranslations, quiz.Description, "", "", "description")
+		for _, q := range questions {
+			missing(q.QuestionTranslations, q.Question, q.ID, "", "question")
+			missing(q.HintTranslations, q.Hint, q.ID, "", "hint")
+			for _, a := range q.Answers {
+				missing(a.TextTranslations, a.Text, q.ID, a.ID, "answer")
+				missing(a.explanationTranslations, a.explanation, q.ID, a.ID, "explanation")
+			}
+		}
+	}
+	return res, nil
+}
+
+// editable loads quiz id after checking the caller may edit it, and
+// canonicalizes locale, which must not be the default locale of the quiz.
+func (u *TranslationsUsecase) editable(ctx context.Context, id string, locale string) (*Quiz, string, error) {
+	locale, err := parseLocale("locale", locale)
+	if err != nil {
+		return nil, "", err
+	}
+	quiz, err := u.quizzes.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, "", err
+	}
+	if err := authorizeEdit(ctx, u.collaborators, quiz, ActorFromContext(ctx)); err != nil {
+		return nil, "", err
+	}
+	if locale == quiz.defaultLocale() {
+		return nil, "", FieldErrors(map[string]string{"locale": "is the default locale of the quiz"})
+	}
+	return quiz, locale, nil
+}
+
+func (u *TranslationsUsecase) updateQuiz(ctx context.Context, before *Quiz, quiz *Quiz) (*Quiz, error) {
+	quiz.UpdatedBy = ActorFromContext(ctx)
+	var res *Quiz
+	err := u.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = u.quizzes.Update(ctx, quiz); err != nil {
+			return err
+		}
+		return audit(ctx, u.audit, AuditUpdate, AuditEntityQuiz, res.ID, res.UserID, before, res)
+	})
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// quizQuestions returns every question quizID owns.
+func (u *TranslationsUsecase) quizQuestions(ctx context.Context, quizID string) ([]*Question, error) {
+	ids, err := u.questions.MatchIDs(ctx, &QuestionFilter{QuizID: quizID})
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	return u.questions.ListByIDs(ctx, ids)
+}
+
+// cloneTranslations copies t so changing it leaves the value it came from,
+// which may be shared with a snapshot taken for the audit log, intact.
+func cloneTranslations(t Translations) Translations {
+	if t == nil {
+		return nil
+	}
+	res := make(Translations, len(t))
+	for k, v := range t {
+		res[k] = v
+	}
+	return res
+}
@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type LeaderboardWindow int
+
+const (
+	LeaderboardAllTime LeaderboardWindow = iota
+	LeaderboardWeekly
+	LeaderboardDaily
+)
+
+// LeaderboardEntry is the best submitted attempt of a user in a window.
+type LeaderboardEntry struct {
+	Rank      int64         `json:"rank"`
+	UserID    string        `json:"user_id"`
+	Score     float32       `json:"score"`
+	TimeSpent time.Duration `json:"time_spent"`
+}
+
+// LeaderboardRepo ranks users by score, the faster attempt winning a tie.
+type LeaderboardRepo interface {
+	// Record enters the attempt into the all-time board and the boards of the
+	// week and day containing at, keeping only the best attempt per user.
+	Record(ctx context.Context, quizID string, entry *LeaderboardEntry, at time.Time) error
+	Top(ctx context.Context, quizID string, window LeaderboardWindow, at time.Time, pagination *Pagination) ([]*LeaderboardEntry, error)
+	// Rank returns nil when the user is not on the board.
+	Rank(ctx context.Context, quizID string, window LeaderboardWindow, at time.Time, userID string) (*LeaderboardEntry, error)
+	// Rebuild replaces the boards of quizID with the entries fill adds, which
+	// readers see all at once when fill returns, or not at all if it fails.
+	Rebuild(ctx context.Context, quizID string, fill func(add func(entry *LeaderboardEntry, at time.Time) error) error) error
+}
+
+type LeaderboardUsecase struct {
+	repo     LeaderboardRepo
+	attempts AttemptsRepo
+	quizzes  QuizRepo
+	admins   *Admins
+	limiter  *RateLimiter
+	log      *log.Helper
+	tracer   trace.Tracer
+}
+
+func NewLeaderboardUsecase(repo LeaderboardRepo, attempts AttemptsRepo, quizzes QuizRepo, admins *Admins, limiter *RateLimiter, logger log.Logger, tracer trace.Tracer) *LeaderboardUsecase {
+	return &LeaderboardUsecase{
+		repo:     repo,
+		attempts: attempts,
+		quizzes:  quizzes,
+		admins:   admins,
+		limiter:  limiter,
+		log:      log.NewHelper(logger),
+		tracer:   tracer,
+	}
+}
+
+// Record enters a submitted attempt. Anonymous attempts are not ranked.
+func (u *LeaderboardUsecase) Record(ctx context.Context, a *Attempt) error {
+	ctx, span := u.tracer.Start(ctx, "biz.LeaderboardUsecase.Record")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", a.QuizID), attribute.String("attempt_id", a.ID))
+
+	entry, ok := leaderboardEntry(a)
+	if !ok {
+		return nil
+	}
+	if err := u.repo.Record(ctx, a.QuizID, entry, *a.SubmittedAt); err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	return nil
+}
+
+func (u *LeaderboardUsecase) GetLeaderboard(ctx context.Context, quizID string, window LeaderboardWindow, at time.Time, callerID string, pagination *Pagination) ([]*LeaderboardEntry, *LeaderboardEntry, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.LeaderboardUsecase.GetLeaderboard")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", quizID), attribute.Int("window", int(window)))
+
+	entries, err := u.repo.Top(ctx, quizID, window, at, pagination)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, nil, err
+	}
+	if callerID == "" {
+		return entries, nil, nil
+	}
+	me, err := u.repo.Rank(ctx, quizID, window, at, callerID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, nil, err
+	}
+	return entries, me, nil
+}
+
+// Rebuild clears the boards of a quiz and replays its submitted attempts from
+// the database, returning how many attempts were ranked. Only the author of
+// the quiz and admins may rebuild, and only a few times per window.
+func (u *LeaderboardUsecase) Rebuild(ctx context.Context, quizID string, userID string) (int64, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.LeaderboardUsecase.Rebuild")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", quizID))
+
+	if userID == "" {
+		return 0, pb.ErrorUnauthorized("sign in to rebuild a leaderboard")
+	}
+	quiz, err := u.quizzes.GetByID(ctx, quizID)
+	if err != nil {
+		u.log.Warn(err)
+		return 0, err
+	}
+	if quiz.UserID != userID && !u.admins.IsAdmin(userID) {
+		return 0, pb.ErrorForbidden("only the author of the quiz or an admin may rebuild its leaderboard")
+	}
+	if err := u.limiter.AllowRebuild(ctx, quiz.ID); err != nil {
+		return 0, err
+	}
+
+	var n int64
+	err = u.repo.Rebuild(ctx, quizID, func(add func(*LeaderboardEntry, time.Time) error) error {
+		return u.attempts.ForEachSubmitted(ctx, quizID, func(a *Attempt) error {
+			entry, ok := leaderboardEntry(a)
+			if !ok {
+				return nil
+			}
+			n++
+			return add(entry, *a.SubmittedAt)
+		})
+	})
+	if err != nil {
+		u.log.Warn(err)
+		return n, err
+	}
+	span.SetAttributes(attribute.Int64("attempts", n))
+	return n, nil
+}
+
+func leaderboardEntry(a *Attempt) (*LeaderboardEntry, bool) {
+	spent, ok := a.TimeSpent()
+	if !ok || a.UserID == "" || a.Score == nil {
+		return nil, false
+	}
+	return &LeaderboardEntry{UserID: a.UserID, Score: *a.Score, TimeSpent: spent}, true
+}
@@ -0,0 +1,337 @@
+package biz
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/conf"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	defaultMaxMediaSize    = 10 << 20
+	maxMediaFilenameLength = 255
+	// MaxAttachments is how many attachments a question or an answer may carry.
+	MaxAttachments = 10
+)
+
+// defaultMediaTypes are accepted for upload unless media.allowed_types says
+// otherwise. They are compared against the type sniffed from the content.
+var defaultMediaTypes = []string{
+	"image/png", "image/jpeg", "image/gif", "image/webp",
+	"audio/mpeg", "audio/wave", "application/ogg",
+	"video/mp4", "video/webm",
+	"text/plain",
+}
+
+type MediaKind int32
+
+const (
+	MediaImage MediaKind = iota
+	MediaAudio
+	MediaVideo
+	MediaCode
+)
+
+// Media is an uploaded file. The content lives in the blob store under Key.
+type Media struct {
+	ID          string
+	OwnerID     string
+	Filename    string
+	ContentType string
+	Size        int64
+	Checksum    string
+	Key         string
+	CreatedAt   time.Time
+}
+
+// Attachment adds an uploaded file to a question or an answer. Code
+// attachments may carry their snippet inline instead.
+type Attachment struct {
+	Kind     MediaKind `json:"kind"`
+	MediaID  string    `json:"media_id,omitempty"`
+	Code     string    `json:"code,omitempty"`
+	Language string    `json:"language,omitempty"`
+	Caption  string    `json:"caption,omitempty"`
+}
+
+type MediaRepo interface {
+	Save(ctx context.Context, m *Media) (*Media, error)
+	GetByID(ctx context.Context, id string) (*Media, error)
+	// GetByIDs returns the files found among ids, in no particular order.
+	GetByIDs(ctx context.Context, ids []string) ([]*Media, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// BlobStore keeps the content of uploaded files.
+type BlobStore interface {
+	// Put stores r under key. size is -1 when unknown.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type MediaUsecase struct {
+	repo    MediaRepo
+	store   BlobStore
+	maxSize int64
+	allowed map[string]bool
+	log     *log.Helper
+	tracer  trace.Tracer
+}
+
+func NewMediaUsecase(bc *conf.Bootstrap, repo MediaRepo, store BlobStore, logger log.Logger, tracer trace.Tracer) *MediaUsecase {
+	u := &MediaUsecase{
+		repo:    repo,
+		store:   store,
+		maxSize: defaultMaxMediaSize,
+		allowed: make(map[string]bool),
+		log:     log.NewHelper(logger),
+		tracer:  tracer,
+	}
+	if n := bc.GetMedia().GetMaxSize(); n > 0 {
+		u.maxSize = n
+	}
+	types := bc.GetMedia().GetAllowedTypes()
+	if len(types) == 0 {
+		types = defaultMediaTypes
+	}
+	for _, t := range types {
+		u.allowed[strings.ToLower(t)] = true
+	}
+	return u
+}
+
+// Upload streams body to the blob store as a file of ownerID. The content
+// type is sniffed from the first bytes rather than trusted from the client,
+// and uploads past the size limit are cut off and rejected.
+func (u *MediaUsecase) Upload(ctx context.Context, ownerID string, filename string, body io.Reader) (*Media, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.MediaUsecase.Upload")
+	defer span.End()
+
+	if ownerID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to upload media")
+	}
+	filename = path.Base(strings.ReplaceAll(strings.TrimSpace(filename), "\\", "/"))
+	if filename == "." || filename == "/" {
+		filename = ""
+	}
+	if utf8.RuneCountInString(filename) > maxMediaFilenameLength {
+		return nil, FieldErrors(map[string]string{"filename": "must be at most 255 characters"})
+	}
+
+	br := bufio.NewReaderSize(body, 512)
+	head, err := br.Peek(512)
+	if err != nil && err != io.EOF {
+		return nil, pb.ErrorInvalidArgument("failed to read upload: %v", err)
+	}
+	if len(head) == 0 {
+		return nil, FieldErrors(map[string]string{"body": "must not be empty"})
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil || !u.allowed[contentType] {
+		return nil, pb.ErrorUnsupportedMediaType("%s files are not accepted", http.DetectContentType(head))
+	}
+	span.SetAttributes(attribute.String("content_type", contentType))
+
+	m := &Media{
+		OwnerID:     ownerID,
+		Filename:    filename,
+		ContentType: contentType,
+		Key:         uuid.New().String(),
+		CreatedAt:   time.Now().UTC(),
+	}
+	hash := sha256.New()
+	limited := &limitedReader{r: io.TeeReader(br, hash), remaining: u.maxSize}
+	if err := u.store.Put(ctx, m.Key, limited, -1, contentType); err != nil {
+		if limited.exceeded {
+			return nil, pb.ErrorMediaTooLarge("uploads are limited to %d bytes", u.maxSize)
+		}
+		u.log.Warn(err)
+		return nil, pb.ErrorInternal("failed to store upload")
+	}
+	m.Size = u.maxSize - limited.remaining
+	m.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	res, err := u.repo.Save(ctx, m)
+	if err != nil {
+		u.log.Warn(err)
+		if err := u.store.Delete(context.WithoutCancel(ctx), m.Key); err != nil {
+			u.log.Warnf("failed to delete orphaned blob %s: %v", m.Key, err)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *MediaUsecase) GetMedia(ctx context.Context, id string) (*Media, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.MediaUsecase.GetMedia")
+	defer span.End()
+
+	res, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// Open returns a file along with its content, which the caller must close.
+func (u *MediaUsecase) Open(ctx context.Context, id string) (*Media, io.ReadCloser, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.MediaUsecase.Open")
+	defer span.End()
+
+	m, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, nil, err
+	}
+	content, err := u.store.Get(ctx, m.Key)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, nil, err
+	}
+	return m, content, nil
+}
+
+// DeleteMedia deletes a file of userID. Questions attaching it keep the
+// attachment, which then no longer resolves.
+func (u *MediaUsecase) DeleteMedia(ctx context.Context, id string, userID string) error {
+	ctx, span := u.tracer.Start(ctx, "biz.MediaUsecase.DeleteMedia")
+	defer span.End()
+
+	m, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	if userID == "" || m.OwnerID != userID {
+		return pb.ErrorForbidden("only the uploader can delete a file")
+	}
+	if err := u.repo.Delete(ctx, id); err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	if err := u.store.Delete(ctx, m.Key); err != nil {
+		u.log.Warnf("failed to delete blob %s: %v", m.Key, err)
+	}
+	return nil
+}
+
+// MediaURL is where the content of file id is served.
+func MediaURL(id string) string {
+	return "/media/" + id + "/content"
+}
+
+// limitedReader fails once more than remaining bytes are read, so that the
+// blob store gives up on an oversized upload instead of storing part of it.
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+	exceeded  bool
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	if int64(n) > l.remaining {
+		l.exceeded = true
+		l.remaining = 0
+		return 0, pb.ErrorMediaTooLarge("upload too large")
+	}
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// validateAttachments checks that every attachment names an uploaded file of
+// its kind, except code attachments, which may inline the snippet instead.
+func validateAttachments(ctx context.Context, repo MediaRepo, field string, atts []Attachment) error {
+	fields := make(map[string]string)
+	if len(atts) > MaxAttachments {
+		fields[field] = fmt.Sprintf("must have at most %d attachments", MaxAttachments)
+	}
+	var ids []string
+	for i, a := range atts {
+		name := fmt.Sprintf("%s[%d]", field, i)
+		switch {
+		case a.Kind < MediaImage || a.Kind > MediaCode:
+			fields[name+".kind"] = "is not a known kind"
+		case a.Kind == MediaCode && a.MediaID != "" && a.Code != "":
+			fields[name] = "must have either code or media_id"
+		case a.Kind == MediaCode && a.MediaID == "" && strings.TrimSpace(a.Code) == "":
+			fields[name] = "must have code or media_id"
+		case a.Kind != MediaCode && a.MediaID == "":
+			fields[name+".media_id"] = "must not be empty"
+		case a.Kind != MediaCode && a.Code != "":
+			fields[name+".code"] = "is only allowed on code attachments"
+		}
+		if a.MediaID != "" {
+			ids = append(ids, a.MediaID)
+		}
+	}
+	if len(fields) > 0 {
+		return FieldErrors(fields)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	found, err := repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*Media, len(found))
+	for _, m := range found {
+		byID[m.ID] = m
+	}
+	for i, a := range atts {
+		if a.MediaID == "" {
+			continue
+		}
+		name := fmt.Sprintf("%s[%d].media_id", field, i)
+		m, ok := byID[a.MediaID]
+		if !ok {
+			fields[name] = "no such file"
+			continue
+		}
+		if !a.Kind.accepts(m.ContentType) {
+			fields[name] = fmt.Sprintf("is a %s file", m.ContentType)
+		}
+	}
+	if len(fields) > 0 {
+		return FieldErrors(fields)
+	}
+	return nil
+}
+
+// accepts reports whether a file of contentType can be attached as k.
+func (k MediaKind) accepts(contentType string) bool {
+	switch k {
+	case MediaImage:
+		return strings.HasPrefix(contentType, "image/")
+	case MediaAudio:
+		return strings.HasPrefix(contentType, "audio/") || contentType == "application/ogg"
+	case MediaVideo:
+		return strings.HasPrefix(contentType, "video/") || contentType == "application/ogg"
+	case MediaCode:
+		return strings.HasPrefix(contentType, "text/")
+	}
+	return false
+}
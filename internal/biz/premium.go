@@ -0,0 +1,83 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+// ProductOwnership reports whether a user holds at least one of the given products.
+type ProductOwnership interface {
+	OwnsAny(ctx context.Context, userID string, productIDs []string) (bool, error)
+}
+
+// PremiumGate decides who may read a premium quiz and its questions.
+type PremiumGate struct {
+	products      ProductsRepo
+	owners        ProductOwnership
+	collaborators CollaboratorsRepo
+	log           *log.Helper
+	tracer        trace.Tracer
+}
+
+func NewPremiumGate(products ProductsRepo, owners ProductOwnership, collaborators CollaboratorsRepo, logger log.Logger, tracer trace.Tracer) *PremiumGate {
+	return &PremiumGate{
+		products:      products,
+		owners:        owners,
+		collaborators: collaborators,
+		log:           log.NewHelper(logger),
+		tracer:        tracer,
+	}
+}
+
+// Authorize returns nil when userID may read q. Free quizzes are open to everyone,
+// premium quizzes only to their author and collaborators and to owners of a
+// product linked to them.
+func (g *PremiumGate) Authorize(ctx context.Context, userID string, q *Quiz) error {
+	ctx, span := g.tracer.Start(ctx, "biz.PremiumGate.Authorize")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", q.ID), attribute.String("user_id", userID))
+
+	if !q.IsPremium() {
+		return nil
+	}
+	if userID == "" {
+		return pb.ErrorUnauthorized("sign in to access premium quizzes")
+	}
+	if userID == q.UserID {
+		return nil
+	}
+	_, collaborates, err := collaboratorRole(ctx, g.collaborators, q.ID, userID)
+	if err != nil {
+		g.log.Warn(err)
+		return err
+	}
+	if collaborates {
+		return nil
+	}
+	products, err := g.products.ListByQuizID(ctx, q.ID)
+	if err != nil {
+		g.log.Warn(err)
+		return err
+	}
+	if len(products) == 0 {
+		return pb.ErrorPremiumRequired("quiz is not available for purchase")
+	}
+	productIDs := make([]string, 0, len(products))
+	for _, p := range products {
+		productIDs = append(productIDs, p.ID)
+	}
+	owns, err := g.owners.OwnsAny(ctx, userID, productIDs)
+	if err != nil {
+		g.log.Warn(err)
+		return err
+	}
+	if !owns {
+		return pb.ErrorPremiumRequired("quiz requires the purchase of a linked product")
+	}
+	return nil
+}
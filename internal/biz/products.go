@@ -6,6 +6,8 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+
+	pb "quiz/api/quizzes/v1"
 )
 
 type Product struct {
@@ -18,6 +20,7 @@ type Product struct {
 	Attributes  map[string]string `json:"attributes"`
 	Thumbnail   *string           `json:"thumbnail"`
 	Images      []string          `json:"images"`
+	QuizIDs     []string          `json:"quiz_ids"`
 }
 
 type ProductsRepo interface {
@@ -27,6 +30,9 @@ type ProductsRepo interface {
 	Update(ctx context.Context, p *Product) (*Product, error)
 	Delete(ctx context.Context, id string) (string, error)
 	Search(ctx context.Context, keyword string, pagination *Pagination) ([]*Product, error)
+	ListByQuizID(ctx context.Context, quizID string) ([]*Product, error)
+	LinkQuizzes(ctx context.Context, id string, quizIDs []string) (*Product, error)
+	UnlinkQuiz(ctx context.Context, id string, quizID string) (*Product, error)
 }
 
 type ProductsUsecase struct {
@@ -128,3 +134,39 @@ func (uc *ProductsUsecase) SearchProducts(ctx context.Context, keyword string, p
 	}
 	return res, nil
 }
+
+func (uc *ProductsUsecase) LinkQuizzes(ctx context.Context, id string, quizIDs []string) (*Product, error) {
+	_, span := otel.Tracer("products").Start(ctx, "LinkQuizzes")
+	defer span.End()
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.StringValue(id),
+	})
+	span.SetAttributes(attribute.StringSlice("quiz_ids", quizIDs))
+	if len(quizIDs) == 0 {
+		return nil, pb.ErrorInvalidArgument("at least one quiz id is required")
+	}
+	res, err := uc.repo.LinkQuizzes(ctx, id, quizIDs)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (uc *ProductsUsecase) UnlinkQuiz(ctx context.Context, id string, quizID string) (*Product, error) {
+	_, span := otel.Tracer("products").Start(ctx, "UnlinkQuiz")
+	defer span.End()
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.StringValue(id),
+	})
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "quiz_id",
+		Value: attribute.StringValue(quizID),
+	})
+	res, err := uc.repo.UnlinkQuiz(ctx, id, quizID)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
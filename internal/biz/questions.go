@@ -2,6 +2,7 @@ package biz
 
 import (
 	"context"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
@@ -11,10 +12,16 @@ import (
 )
 
 type Answer struct {
-	ID          string `json:"id"`
-	Text        string `json:"text"`
-	isCorrect   bool
-	explanation string
+	ID                      string        `json:"id"`
+	Text                    string        `json:"text"`
+	TextTranslations        Translations  `json:"text_translations"`
+	Pinned                  bool          `json:"pinned"`
+	Media                   []Attachment  `json:"media"`
+	Format                  ContentFormat `json:"format"`
+	ExplanationFormat       ContentFormat `json:"explanation_format"`
+	isCorrect               bool
+	explanation             string
+	explanationTranslations Translations
 }
 
 func (a *Answer) IsCorrect() bool {
@@ -34,6 +41,15 @@ func (a *Answer) SetExplanation(explanation string) *Answer {
 	return a
 }
 
+func (a *Answer) ExplanationTranslations() Translations {
+	return a.explanationTranslations
+}
+
+func (a *Answer) SetExplanationTranslations(t Translations) *Answer {
+	a.explanationTranslations = t
+	return a
+}
+
 type Question struct {
 	ID         string `json:"id"`
 	QuizID     string `json:"quiz_id"`
@@ -41,13 +57,23 @@ type Question struct {
 	Difficulty uint64 `json:"difficulty"`
 	Order      float64
 	Answers    []Answer
-	Hint       string `json:"hint"`
-	CreatedAt  string `json:"created_at"`
-	UpdatedAt  string `json:"updated_at"`
-	DeletedAt  string `json:"deleted_at"`
-	CreatedBy  string `json:"created_by"`
-	UpdatedBy  string `json:"updated_by"`
-	DeletedBy  string `json:"deleted_by"`
+	Hint       string       `json:"hint"`
+	Tags       []string     `json:"tags"`
+	Media      []Attachment `json:"media"`
+	// Format is the format of the question text and the hint.
+	Format ContentFormat `json:"format"`
+	// QuestionTranslations and HintTranslations hold the text in the locales
+	// of the quiz other than its default one.
+	QuestionTranslations Translations `json:"question_translations"`
+	HintTranslations     Translations `json:"hint_translations"`
+	// Locale is the locale Localize put the text in. It isn't stored.
+	Locale    string `json:"-"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+	DeletedAt string `json:"deleted_at"`
+	CreatedBy string `json:"created_by"`
+	UpdatedBy string `json:"updated_by"`
+	DeletedBy string `json:"deleted_by"`
 }
 
 type ReorderPayload struct {
@@ -57,44 +83,107 @@ type ReorderPayload struct {
 	BelowID   string
 }
 
+// QuestionFilter narrows questions down to one quiz, or to the shared bank when
+// QuizID is empty. Every tag has to be present on a matching question.
+type QuestionFilter struct {
+	QuizID     string
+	Tags       []string
+	Difficulty *uint64
+}
+
 type QuestionsRepo interface {
 	Save(ctx context.Context, q *Question) (*Question, error)
 	GetByID(ctx context.Context, id string) (*Question, error)
 	List(ctx context.Context, quizID string, pagination *Pagination) ([]*Question, error)
+	ListByIDs(ctx context.Context, ids []string) ([]*Question, error)
+	Search(ctx context.Context, filter *QuestionFilter, pagination *Pagination) ([]*Question, error)
+	// MatchIDs returns the IDs of every matching question in a stable order.
+	MatchIDs(ctx context.Context, filter *QuestionFilter) ([]string, error)
 	Update(ctx context.Context, q *Question) (*Question, error)
 	Delete(ctx context.Context, id string) (*Question, error)
 }
 
 type QuestionsUsecase struct {
-	repo   QuestionsRepo
-	log    *log.Helper
-	tracer trace.Tracer
+	repo          QuestionsRepo
+	quizzes       QuizRepo
+	collaborators CollaboratorsRepo
+	gate          *PremiumGate
+	media         MediaRepo
+	limiter       *RateLimiter
+	admins        *Admins
+	tx            Transaction
+	audit         AuditRepo
+	log           *log.Helper
+	tracer        trace.Tracer
 }
 
-func NewQuestionUsecase(repo QuestionsRepo, logger log.Logger, tracer trace.Tracer) *QuestionsUsecase {
+func NewQuestionUsecase(repo QuestionsRepo, quizzes QuizRepo, collaborators CollaboratorsRepo, gate *PremiumGate, media MediaRepo, limiter *RateLimiter, admins *Admins, tx Transaction, audit AuditRepo, logger log.Logger, tracer trace.Tracer) *QuestionsUsecase {
 	return &QuestionsUsecase{
-		repo:   repo,
-		log:    log.NewHelper(logger),
-		tracer: tracer,
+		repo:          repo,
+		quizzes:       quizzes,
+		collaborators: collaborators,
+		gate:          gate,
+		media:         media,
+		limiter:       limiter,
+		admins:        admins,
+		tx:            tx,
+		audit:         audit,
+		log:           log.NewHelper(logger),
+		tracer:        tracer,
 	}
 }
 func (u *QuestionsUsecase) CreateQuestion(ctx context.Context, q *Question, _answers []*pb.AnswerCreation) (*Question, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuestionsUsecase.CreateQuestion")
 	defer span.End()
 
+	q.CreatedBy = ActorFromContext(ctx)
+	if err := u.authorizeEdit(ctx, q); err != nil {
+		return nil, err
+	}
 	answers := make([]Answer, 0, len(_answers))
 	for _, a := range _answers {
 		answers = append(answers, Answer{
 			ID:          uuid.New().String(),
 			Text:        a.Text,
 			isCorrect:   a.IsCorrect,
-			explanation: *a.Explanation,
+			explanation: a.GetExplanation(),
+			Pinned:      a.GetPinned(),
+			Media:       AttachmentsFromPb(a.GetMedia()),
+
+			Format:            ContentFormat(a.GetFormat()),
+			ExplanationFormat: ContentFormat(a.GetExplanationFormat()),
 		})
 	}
 
-	q.Answers = answers
+	if err := validateQuestionText(q.Question); err != nil {
+		return nil, err
+	}
+	if err := validateAnswerSet(answers); err != nil {
+		return nil, err
+	}
+	if err := validateAttachments(ctx, u.media, "media", q.Media); err != nil {
+		return nil, err
+	}
+	if err := u.validateAnswerMedia(ctx, answers); err != nil {
+		return nil, err
+	}
 
-	res, err := u.repo.Save(ctx, q)
+	q.Answers = answers
+	sanitizeQuestion(q)
+	q.UpdatedBy = q.CreatedBy
+
+	var res *Question
+	err := u.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = u.repo.Save(ctx, q); err != nil {
+			return err
+		}
+		owner, err := u.owner(ctx, res.QuizID)
+		if err != nil {
+			return err
+		}
+		return audit(ctx, u.audit, AuditCreate, AuditEntityQuestion, res.ID, owner, nil, questionAudit(res))
+	})
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
@@ -102,7 +191,9 @@ func (u *QuestionsUsecase) CreateQuestion(ctx context.Context, q *Question, _ans
 	return res, nil
 }
 
-func (u *QuestionsUsecase) GetQuestion(ctx context.Context, id string) (*Question, error) {
+// GetQuestion returns a question in the locale of its quiz best matching
+// locale, a list of preferred locales in Accept-Language syntax.
+func (u *QuestionsUsecase) GetQuestion(ctx context.Context, id string, userID string, locale string) (*Question, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuestionsUsecase.GetQuestion")
 	defer span.End()
 
@@ -111,26 +202,85 @@ func (u *QuestionsUsecase) GetQuestion(ctx context.Context, id string) (*Questio
 		u.log.Warn(err)
 		return nil, err
 	}
-	return res, nil
+	// bank questions belong to no quiz and have no gate to pass
+	var quiz *Quiz
+	if res.QuizID != "" {
+		quiz, err = u.authorizeQuiz(ctx, res.QuizID, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return localizeQuestions(quiz, locale, []*Question{res})[0], nil
 }
 
-func (u *QuestionsUsecase) ListQuestion(ctx context.Context, quizID string, pagination *Pagination) ([]*Question, error) {
+func (u *QuestionsUsecase) ListQuestion(ctx context.Context, quizID string, userID string, locale string, pagination *Pagination) ([]*Question, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuestionsUsecase.ListQuestion")
 	defer span.End()
 
+	quiz, err := u.authorizeQuiz(ctx, quizID, userID)
+	if err != nil {
+		return nil, err
+	}
 	res, err := u.repo.List(ctx, quizID, pagination)
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
 	}
-	return res, nil
+	return localizeQuestions(quiz, locale, res), nil
+}
+
+func (u *QuestionsUsecase) ListBankQuestions(ctx context.Context, tags []string, difficulty *uint64, locale string, pagination *Pagination) ([]*Question, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.QuestionsUsecase.ListBankQuestions")
+	defer span.End()
+
+	res, err := u.repo.Search(ctx, &QuestionFilter{Tags: tags, Difficulty: difficulty}, pagination)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return localizeQuestions(nil, locale, res), nil
 }
 
-func (u *QuestionsUsecase) UpdateQuestion(ctx context.Context, q *Question) (*Question, error) {
+// UpdateQuestion applies the set fields of q to the stored question, keeping its
+// answers. A non-nil empty Media removes the attachments of the question. hint
+// and difficulty are applied when set, even to their zero values, and format,
+// when set, changes the format of the question text and the hint.
+func (u *QuestionsUsecase) UpdateQuestion(ctx context.Context, q *Question, hint *string, difficulty *uint64, format *ContentFormat) (*Question, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuestionsUsecase.UpdateQuestion")
 	defer span.End()
 
-	res, err := u.repo.Update(ctx, q)
+	existing, err := u.repo.GetByID(ctx, q.ID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	before := questionAudit(existing)
+	if q.Question != "" {
+		if err := validateQuestionText(q.Question); err != nil {
+			return nil, err
+		}
+		existing.Question = q.Question
+	}
+	if hint != nil {
+		existing.Hint = *hint
+	}
+	if difficulty != nil {
+		existing.Difficulty = *difficulty
+	}
+	if q.Tags != nil {
+		existing.Tags = q.Tags
+	}
+	if q.Media != nil {
+		if err := validateAttachments(ctx, u.media, "media", q.Media); err != nil {
+			return nil, err
+		}
+		existing.Media = q.Media
+	}
+	if format != nil {
+		existing.Format = *format
+	}
+
+	res, err := u.update(ctx, before, existing)
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
@@ -142,7 +292,24 @@ func (u *QuestionsUsecase) DeleteQuestion(ctx context.Context, id string) (*Ques
 	ctx, span := u.tracer.Start(ctx, "biz.QuestionsUsecase.DeleteQuestion")
 	defer span.End()
 
-	res, err := u.repo.Delete(ctx, id)
+	var res *Question
+	err := u.tx.InTx(ctx, func(ctx context.Context) error {
+		q, err := u.repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeEdit(ctx, q); err != nil {
+			return err
+		}
+		if res, err = u.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		owner, err := u.owner(ctx, res.QuizID)
+		if err != nil {
+			return err
+		}
+		return audit(ctx, u.audit, AuditDelete, AuditEntityQuestion, id, owner, questionAudit(res), nil)
+	})
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
@@ -150,18 +317,30 @@ func (u *QuestionsUsecase) DeleteQuestion(ctx context.Context, id string) (*Ques
 	return res, nil
 }
 
-func (u *QuestionsUsecase) ValidateQuestionAnswers(ctx context.Context, questionID string, uAnswers []*pb.UserAnswer) (*pb.ValidateQuestionAnswersResponse, error) {
+// ValidateQuestionAnswers grades uAnswers, which userID may only do for
+// questions of quizzes they may read.
+func (u *QuestionsUsecase) ValidateQuestionAnswers(ctx context.Context, questionID string, userID string, uAnswers []*pb.UserAnswer) (*pb.ValidateQuestionAnswersResponse, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuestionsUsecase.ValidateQuestionAnswers")
 	defer span.End()
 
+	// checked before anything else so that a caller over the cap learns
+	// nothing, not even whether the question exists
+	if err := u.limiter.AllowValidation(ctx, questionID); err != nil {
+		return nil, err
+	}
 	q, err := u.repo.GetByID(ctx, questionID)
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
 	}
+	if q.QuizID != "" {
+		if _, err := u.authorizeQuiz(ctx, q.QuizID, userID); err != nil {
+			return nil, err
+		}
+	}
 	answers := q.Answers
 	if len(answers) == 0 {
-		return nil, errors.BadRequest("Invalid question", "question has no answers")
+		return nil, pb.ErrorInvalidArgument("question has no answers")
 	}
 	results, score, err := validateAnswers(q.Answers, uAnswers)
 	if err != nil {
@@ -180,10 +359,10 @@ func (u *QuestionsUsecase) ReorderQuestion(ctx context.Context, questionID strin
 	ctx, span := u.tracer.Start(ctx, "biz.QuestionsUsecase.ReorderQuestion")
 	defer span.End()
 
-	return nil, errors.InternalServer("not implemented", "not implemented")
+	return nil, pb.ErrorNotImplemented("not implemented")
 }
 
-func (u *QuestionsUsecase) AddAnswer(ctx context.Context, questionID string, answer pb.AnswerCreation) (*pb.Answer, error) {
+func (u *QuestionsUsecase) AddAnswer(ctx context.Context, questionID string, answer *pb.AnswerCreation) (*pb.Answer, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuestionsUsecase.AddAnswer")
 	defer span.End()
 	q, err := u.repo.GetByID(ctx, questionID)
@@ -191,37 +370,39 @@ func (u *QuestionsUsecase) AddAnswer(ctx context.Context, questionID string, ans
 		u.log.Warn(err)
 		return nil, err
 	}
+	before := questionAudit(q)
 
 	newAnswer := &Answer{
 		ID:          bson.NewObjectID().Hex(),
 		Text:        answer.Text,
 		isCorrect:   answer.IsCorrect,
-		explanation: *answer.Explanation,
+		explanation: answer.GetExplanation(),
+		Pinned:      answer.GetPinned(),
+		Media:       AttachmentsFromPb(answer.GetMedia()),
+
+		Format:            ContentFormat(answer.GetFormat()),
+		ExplanationFormat: ContentFormat(answer.GetExplanationFormat()),
 	}
 	newAnswers := append(q.Answers, *newAnswer)
+	if err := validateAnswerSet(newAnswers); err != nil {
+		return nil, err
+	}
+	if err := validateAttachments(ctx, u.media, "answer.media", newAnswer.Media); err != nil {
+		return nil, err
+	}
 	q.Answers = newAnswers
 
-	res, err := u.repo.Update(ctx, q)
+	res, err := u.update(ctx, before, q)
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
 	}
 	var resAnswers []*pb.Answer
 	for _, a := range res.Answers {
-		resAnswers = append(resAnswers, &pb.Answer{
-			Id:          a.ID,
-			Text:        a.Text,
-			IsCorrect:   a.isCorrect,
-			Explanation: &a.explanation,
-		})
+		resAnswers = append(resAnswers, answerToPb(&a))
 	}
 
-	return &pb.Answer{
-		Id:          newAnswer.ID,
-		Text:        newAnswer.Text,
-		IsCorrect:   newAnswer.isCorrect,
-		Explanation: &newAnswer.explanation,
-	}, nil
+	return answerToPb(newAnswer), nil
 }
 
 func (u *QuestionsUsecase) DeleteAnswer(ctx context.Context, QuestionID string, AnswerID string) (*pb.DeleteAnswerResponse, error) {
@@ -233,9 +414,10 @@ func (u *QuestionsUsecase) DeleteAnswer(ctx context.Context, QuestionID string,
 		u.log.Warn(err)
 		return nil, err
 	}
+	before := questionAudit(q)
 	answers := q.Answers
 	if len(answers) == 0 {
-		return nil, errors.BadRequest("Invalid question", "question has no answers")
+		return nil, pb.ErrorInvalidArgument("question has no answers")
 	}
 	newAnswers := make([]Answer, 0, len(answers)-1)
 	for _, a := range answers {
@@ -243,21 +425,19 @@ func (u *QuestionsUsecase) DeleteAnswer(ctx context.Context, QuestionID string,
 			newAnswers = append(newAnswers, a)
 		}
 	}
+	if err := validateAnswerSet(newAnswers); err != nil {
+		return nil, err
+	}
 	q.Answers = newAnswers
 
-	res, err := u.repo.Update(ctx, q)
+	res, err := u.update(ctx, before, q)
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
 	}
 	var resAnswers []*pb.Answer
 	for _, a := range res.Answers {
-		resAnswers = append(resAnswers, &pb.Answer{
-			Id:          a.ID,
-			Text:        a.Text,
-			IsCorrect:   a.isCorrect,
-			Explanation: &a.explanation,
-		})
+		resAnswers = append(resAnswers, answerToPb(&a))
 	}
 
 	return &pb.DeleteAnswerResponse{
@@ -277,45 +457,52 @@ func (u *QuestionsUsecase) OverrideAnswer(ctx context.Context, request *pb.Overr
 		u.log.Warn(err)
 		return nil, err
 	}
+	before := questionAudit(q)
 	answers := q.Answers
 	if len(answers) == 0 {
-		return nil, errors.BadRequest("Invalid question", "question has no answers")
+		return nil, pb.ErrorInvalidArgument("question has no answers")
 	}
 	if request.GetAnswerId() == "" {
-		return nil, errors.BadRequest("Invalid answer ID", "answer ID is empty")
+		return nil, pb.ErrorInvalidArgument("answer ID is empty")
 	}
+	// point into q.Answers so the override is what gets stored
 	var target *Answer
-	for _, a := range answers {
-		if a.ID == request.GetAnswerId() {
-			target = &a
+	for i := range answers {
+		if answers[i].ID == request.GetAnswerId() {
+			target = &answers[i]
 		}
 	}
 	if target == nil {
-		return nil, errors.BadRequest("Invalid target ID", "target ID is empty")
+		return nil, pb.ErrorInvalidArgument("target ID is empty")
 	}
 	target.Text = request.GetAnswer().GetText()
 	target.isCorrect = request.GetAnswer().GetIsCorrect()
 	target.explanation = request.GetAnswer().GetExplanation()
+	target.Pinned = request.GetAnswer().GetPinned()
+	target.Media = AttachmentsFromPb(request.GetAnswer().GetMedia())
+	target.Format = ContentFormat(request.GetAnswer().GetFormat())
+	target.ExplanationFormat = ContentFormat(request.GetAnswer().GetExplanationFormat())
+	if err := validateAnswerSet(answers); err != nil {
+		return nil, err
+	}
+	if err := validateAttachments(ctx, u.media, "answer.media", target.Media); err != nil {
+		return nil, err
+	}
 
-	res, err := u.repo.Update(ctx, q)
+	res, err := u.update(ctx, before, q)
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
 	}
 	var resAnswers []*pb.Answer
 	for _, a := range res.Answers {
-		resAnswers = append(resAnswers, &pb.Answer{
-			Id:          a.ID,
-			Text:        a.Text,
-			IsCorrect:   a.isCorrect,
-			Explanation: &a.explanation,
-		})
+		resAnswers = append(resAnswers, answerToPb(&a))
 	}
 
 	return &pb.OverrideAnswerResponse{
 		QuestionId: res.ID,
 		QuizId:     res.QuizID,
-		Answer:     &pb.Answer{Id: request.GetAnswerId(), Text: target.Text, IsCorrect: target.isCorrect, Explanation: &target.explanation},
+		Answer:     answerToPb(target),
 	}, nil
 }
 
@@ -328,6 +515,7 @@ func (u *QuestionsUsecase) PutAnswers(ctx context.Context, QuestionID string, an
 		u.log.Warn(err)
 		return nil, err
 	}
+	before := questionAudit(q)
 	newAnswers := make([]Answer, 0, len(answers))
 	for _, a := range answers {
 		newID := bson.NewObjectID().Hex()
@@ -335,25 +523,31 @@ func (u *QuestionsUsecase) PutAnswers(ctx context.Context, QuestionID string, an
 			ID:          newID,
 			Text:        a.Text,
 			isCorrect:   a.IsCorrect,
-			explanation: *a.Explanation,
+			explanation: a.GetExplanation(),
+			Pinned:      a.GetPinned(),
+			Media:       AttachmentsFromPb(a.GetMedia()),
+
+			Format:            ContentFormat(a.GetFormat()),
+			ExplanationFormat: ContentFormat(a.GetExplanationFormat()),
 		})
 	}
+	if err := validateAnswerSet(newAnswers); err != nil {
+		return nil, err
+	}
+	if err := u.validateAnswerMedia(ctx, newAnswers); err != nil {
+		return nil, err
+	}
 
 	q.Answers = newAnswers
 
-	res, err := u.repo.Update(ctx, q)
+	res, err := u.update(ctx, before, q)
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
 	}
 	var resAnswers []*pb.Answer
 	for _, a := range res.Answers {
-		resAnswers = append(resAnswers, &pb.Answer{
-			Id:          a.ID,
-			Text:        a.Text,
-			IsCorrect:   a.isCorrect,
-			Explanation: &a.explanation,
-		})
+		resAnswers = append(resAnswers, answerToPb(&a))
 	}
 
 	return resAnswers, nil
@@ -368,9 +562,10 @@ func (u *QuestionsUsecase) ReorderAnswers(ctx context.Context, response *pb.Reor
 		u.log.Warn(err)
 		return nil, err
 	}
+	before := questionAudit(q)
 	answers := q.Answers
 	if len(answers) != len(response.GetAnswerIds()) {
-		return nil, errors.BadRequest("Invalid answer IDs", "answer IDs are not equal to number of answers")
+		return nil, pb.ErrorInvalidArgument("answer IDs are not equal to number of answers")
 	}
 
 	newOrder := make([]Answer, 0, len(response.GetAnswerIds()))
@@ -384,19 +579,14 @@ func (u *QuestionsUsecase) ReorderAnswers(ctx context.Context, response *pb.Reor
 		}
 	}
 	q.Answers = newOrder
-	res, err := u.repo.Update(ctx, q)
+	res, err := u.update(ctx, before, q)
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
 	}
 	pbAnswers := make([]*pb.Answer, 0, len(res.Answers))
 	for _, a := range res.Answers {
-		pbAnswers = append(pbAnswers, &pb.Answer{
-			Id:          a.ID,
-			Text:        a.Text,
-			IsCorrect:   a.isCorrect,
-			Explanation: &a.explanation,
-		})
+		pbAnswers = append(pbAnswers, answerToPb(&a))
 	}
 	return &pb.ReorderAnswersResponse{
 		QuestionId: response.GetQuestionId(),
@@ -450,9 +640,94 @@ func (u *QuestionsUsecase) ReorderAnswers(ctx context.Context, response *pb.Reor
 //	}, nil
 //}
 
+// update sanitises and stores q and audits the change from before, once the
+// caller is found to be allowed to edit it.
+func (u *QuestionsUsecase) update(ctx context.Context, before *auditQuestion, q *Question) (*Question, error) {
+	if err := u.authorizeEdit(ctx, q); err != nil {
+		return nil, err
+	}
+	sanitizeQuestion(q)
+	q.UpdatedBy = ActorFromContext(ctx)
+	var res *Question
+	err := u.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = u.repo.Update(ctx, q); err != nil {
+			return err
+		}
+		owner, err := u.owner(ctx, res.QuizID)
+		if err != nil {
+			return err
+		}
+		return audit(ctx, u.audit, AuditUpdate, AuditEntityQuestion, res.ID, owner, before, questionAudit(res))
+	})
+	return res, err
+}
+
+// validateAnswerMedia checks the attachments of new answers.
+func (u *QuestionsUsecase) validateAnswerMedia(ctx context.Context, answers []Answer) error {
+	for i, a := range answers {
+		if err := validateAttachments(ctx, u.media, fmt.Sprintf("answers[%d].media", i), a.Media); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// owner returns the author of quizID, or "" for bank questions and quizzes
+// that are gone.
+func (u *QuestionsUsecase) owner(ctx context.Context, quizID string) (string, error) {
+	if quizID == "" {
+		return "", nil
+	}
+	quiz, err := u.quizzes.GetByID(ctx, quizID)
+	if errors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return quiz.UserID, nil
+}
+
+// authorizeEdit checks that the caller may change q: the editors of its quiz,
+// or for bank questions, which belong to no quiz, whoever created it and
+// admins.
+func (u *QuestionsUsecase) authorizeEdit(ctx context.Context, q *Question) error {
+	if q.QuizID == "" {
+		userID := ActorFromContext(ctx)
+		if userID == "" {
+			return pb.ErrorUnauthorized("sign in to edit bank questions")
+		}
+		if userID != q.CreatedBy && !u.admins.IsAdmin(userID) {
+			return pb.ErrorForbidden("only the author of a bank question or an admin may edit it")
+		}
+		return nil
+	}
+	quiz, err := u.quizzes.GetByID(ctx, q.QuizID)
+	if err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	return authorizeEdit(ctx, u.collaborators, quiz, ActorFromContext(ctx))
+}
+
+// authorizeQuiz returns quizID after checking that userID may read its
+// questions.
+func (u *QuestionsUsecase) authorizeQuiz(ctx context.Context, quizID string, userID string) (*Quiz, error) {
+	quiz, err := u.quizzes.GetByID(ctx, quizID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if err := u.gate.Authorize(ctx, userID, quiz); err != nil {
+		return nil, err
+	}
+	return quiz, nil
+}
+
 func validateAnswers(answers []Answer, userAnswers []*pb.UserAnswer) ([]*pb.AnswerResult, float32, error) {
 	if len(answers) != len(userAnswers) {
-		return nil, 0, errors.BadRequest("invalid user answers", "number of user answers is not equal to number of answers")
+		return nil, 0, pb.ErrorInvalidArgument("number of user answers is not equal to number of answers")
 	}
 
 	// map for faster lookups
@@ -496,7 +771,7 @@ func validateAnswers(answers []Answer, userAnswers []*pb.UserAnswer) ([]*pb.Answ
 // ReorderAnswers NOTE: DEPRECATED
 func ReorderAnswers(answers []Answer, payload ReorderPayload, targetID string) ([]Answer, error) {
 	if len(answers) == 0 {
-		return nil, errors.BadRequest("Invalid question", "question has no answers")
+		return nil, pb.ErrorInvalidArgument("question has no answers")
 	}
 	if len(answers) == 1 {
 		return answers, nil
@@ -509,7 +784,7 @@ func ReorderAnswers(answers []Answer, payload ReorderPayload, targetID string) (
 		}
 	}
 	if target == nil {
-		return nil, errors.BadRequest("Invalid target ID", "target ID is empty")
+		return nil, pb.ErrorInvalidArgument("target ID is empty")
 	}
 	if payload.MakeFirst {
 		newAnswers = append(newAnswers, *target)
@@ -542,7 +817,7 @@ func ReorderAnswers(answers []Answer, payload ReorderPayload, targetID string) (
 			}
 		}
 	} else {
-		return nil, errors.BadRequest("Invalid payload", "payload is invalid")
+		return nil, pb.ErrorInvalidArgument("payload is invalid")
 	}
 
 	return answers, nil
@@ -2,8 +2,14 @@ package biz
 
 import (
 	"context"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/trace"
+	"slices"
+	"time"
+
+	pb "quiz/api/quizzes/v1"
 )
 
 type Quiz struct {
@@ -17,12 +23,53 @@ type Quiz struct {
 	Category    *string           `json:"category"`
 	Tags        []string          `json:"tags"`
 	Metadata    map[string]string `json:"metadata"`
-	CreatedAt   string            `json:"created_at"`
-	UpdatedAt   string            `json:"updated_at"`
-	DeletedAt   string            `json:"deleted_at"`
-	CreatedBy   string            `json:"created_by"`
-	UpdatedBy   string            `json:"updated_by"`
-	DeletedBy   string            `json:"deleted_by"`
+	Premium     *bool             `json:"premium"`
+	QuestionIDs []string          `json:"question_ids"`
+	DrawRules   []DrawRule        `json:"draw_rules"`
+	Shuffle     *ShuffleSettings  `json:"shuffle"`
+	Adaptive    *AdaptiveSettings `json:"adaptive"`
+	PublishedAt string            `json:"published_at"`
+	// DefaultLocale is the locale Title and Description are written in.
+	DefaultLocale string `json:"default_locale"`
+	// Locales lists the other locales the quiz is translated to.
+	Locales                 []string     `json:"locales"`
+	TitleTranslations       Translations `json:"title_translations"`
+	DescriptionTranslations Translations `json:"description_translations"`
+	// Locale is the locale Localize put the text in. It isn't stored.
+	Locale    string `json:"-"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+	DeletedAt string `json:"deleted_at"`
+	CreatedBy string `json:"created_by"`
+	UpdatedBy string `json:"updated_by"`
+	DeletedBy string `json:"deleted_by"`
+}
+
+// ShuffleSettings randomizes the question and answer order of each attempt.
+type ShuffleSettings struct {
+	Questions bool `json:"questions"`
+	Answers   bool `json:"answers"`
+}
+
+// AdaptiveSettings makes attempts ask Length questions one at a time, picking
+// each by the learner's running performance.
+type AdaptiveSettings struct {
+	Length          uint32  `json:"length"`
+	StartDifficulty *uint64 `json:"start_difficulty"`
+}
+
+// DrawRule picks Count random bank questions carrying every tag in Tags and,
+// when set, the given difficulty. "5 random HARD questions tagged networking"
+// is DrawRule{Count: 5, Difficulty: HARD, Tags: ["networking"]}.
+type DrawRule struct {
+	Count      uint32   `json:"count"`
+	Difficulty *uint64  `json:"difficulty"`
+	Tags       []string `json:"tags"`
+}
+
+// IsPremium reports whether the quiz is gated behind a product purchase.
+func (q *Quiz) IsPremium() bool {
+	return q.Premium != nil && *q.Premium
 }
 
 type QuizRepo interface {
@@ -32,18 +79,44 @@ type QuizRepo interface {
 	Update(ctx context.Context, q *Quiz) (*Quiz, error)
 	Delete(ctx context.Context, id string) (*Quiz, error)
 	Search(ctx context.Context, keyword string, pagination *Pagination) ([]*Quiz, error)
+	// Publish stamps the quiz as published at the given RFC3339 time.
+	Publish(ctx context.Context, id string, at string) (*Quiz, error)
+}
+
+// CloneOverrides replaces fields of a cloned quiz. Nil or empty ones keep the
+// value of the source quiz.
+type CloneOverrides struct {
+	Title  *string
+	UserID *string
+	Tags   []string
 }
+
+// MetadataClonedFrom is the metadata key holding the ID a quiz was cloned from.
+const MetadataClonedFrom = "cloned_from"
+
 type QuizUsecase struct {
-	repo   QuizRepo
-	log    *log.Helper
-	tracer trace.Tracer
+	repo          QuizRepo
+	questions     QuestionsRepo
+	collaborators CollaboratorsRepo
+	gate          *PremiumGate
+	tx            Transaction
+	outbox        OutboxRepo
+	audit         AuditRepo
+	log           *log.Helper
+	tracer        trace.Tracer
 }
 
-func NewQuizUsecase(repo QuizRepo, logger log.Logger, tracer trace.Tracer) *QuizUsecase {
+func NewQuizUsecase(repo QuizRepo, questions QuestionsRepo, collaborators CollaboratorsRepo, gate *PremiumGate, tx Transaction, outbox OutboxRepo, audit AuditRepo, logger log.Logger, tracer trace.Tracer) *QuizUsecase {
 	return &QuizUsecase{
-		repo:   repo,
-		log:    log.NewHelper(logger),
-		tracer: tracer,
+		repo:          repo,
+		questions:     questions,
+		collaborators: collaborators,
+		gate:          gate,
+		tx:            tx,
+		outbox:        outbox,
+		audit:         audit,
+		log:           log.NewHelper(logger),
+		tracer:        tracer,
 	}
 }
 
@@ -51,7 +124,35 @@ func (u *QuizUsecase) CreateQuiz(ctx context.Context, q *Quiz) (*Quiz, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuizUsecase.CreateQuiz")
 	defer span.End()
 
-	res, err := u.repo.Save(ctx, q)
+	if q.UserID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to create a quiz")
+	}
+	if err := validateNewQuiz(q); err != nil {
+		return nil, err
+	}
+	if q.DefaultLocale == "" {
+		q.DefaultLocale = DefaultLocale
+	}
+	locale, err := parseLocale("default_locale", q.DefaultLocale)
+	if err != nil {
+		return nil, err
+	}
+	q.DefaultLocale = locale
+	if err := u.checkQuestionIDs(ctx, q.QuestionIDs); err != nil {
+		return nil, err
+	}
+
+	var res *Quiz
+	err = u.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = u.repo.Save(ctx, q); err != nil {
+			return err
+		}
+		if err := audit(ctx, u.audit, AuditCreate, AuditEntityQuiz, res.ID, res.UserID, nil, res); err != nil {
+			return err
+		}
+		return record(ctx, u.outbox, EventQuizCreated, res.ID, res)
+	})
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
@@ -59,7 +160,9 @@ func (u *QuizUsecase) CreateQuiz(ctx context.Context, q *Quiz) (*Quiz, error) {
 	return res, nil
 }
 
-func (u *QuizUsecase) GetQuiz(ctx context.Context, id string) (*Quiz, error) {
+// GetQuiz returns a quiz in its locale best matching locale, a list of
+// preferred locales in Accept-Language syntax.
+func (u *QuizUsecase) GetQuiz(ctx context.Context, id string, userID string, locale string) (*Quiz, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuizUsecase.GetQuiz")
 	defer span.End()
 
@@ -68,18 +171,25 @@ func (u *QuizUsecase) GetQuiz(ctx context.Context, id string) (*Quiz, error) {
 		u.log.Warn(err)
 		return nil, err
 	}
-	return res, nil
+	if err := u.gate.Authorize(ctx, userID, res); err != nil {
+		return nil, err
+	}
+	return res.Localize(MatchLocale(locale, res.AvailableLocales())), nil
 }
 
-func (u *QuizUsecase) ListQuiz(ctx context.Context, pagination *Pagination) ([]*Quiz, error) {
+func (u *QuizUsecase) ListQuiz(ctx context.Context, locale string, pagination *Pagination) ([]*Quiz, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuizUsecase.ListQuiz")
 	defer span.End()
 
-	res, err := u.repo.List(ctx, pagination)
+	quizzes, err := u.repo.List(ctx, pagination)
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
 	}
+	res := make([]*Quiz, 0, len(quizzes))
+	for _, q := range quizzes {
+		res = append(res, q.Localize(MatchLocale(locale, q.AvailableLocales())))
+	}
 	return res, nil
 }
 
@@ -87,7 +197,40 @@ func (u *QuizUsecase) UpdateQuiz(ctx context.Context, q *Quiz) (*Quiz, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuizUsecase.UpdateQuiz")
 	defer span.End()
 
-	res, err := u.repo.Update(ctx, q)
+	if err := validateQuiz(q); err != nil {
+		return nil, err
+	}
+	if q.DefaultLocale != "" {
+		var err error
+		if q.DefaultLocale, err = parseLocale("default_locale", q.DefaultLocale); err != nil {
+			return nil, err
+		}
+	}
+	if err := u.checkQuestionIDs(ctx, q.QuestionIDs); err != nil {
+		return nil, err
+	}
+	q.UpdatedBy = ActorFromContext(ctx)
+
+	var res *Quiz
+	err := u.tx.InTx(ctx, func(ctx context.Context) error {
+		before, err := u.repo.GetByID(ctx, q.ID)
+		if err != nil {
+			return err
+		}
+		if err := authorizeEdit(ctx, u.collaborators, before, q.UpdatedBy); err != nil {
+			return err
+		}
+		if q.DefaultLocale != "" && slices.Contains(before.Locales, q.DefaultLocale) {
+			return FieldErrors(map[string]string{"default_locale": "is a translated locale of the quiz; remove it first"})
+		}
+		if res, err = u.repo.Update(ctx, q); err != nil {
+			return err
+		}
+		if err := audit(ctx, u.audit, AuditUpdate, AuditEntityQuiz, res.ID, res.UserID, before, res); err != nil {
+			return err
+		}
+		return record(ctx, u.outbox, EventQuizUpdated, res.ID, res)
+	})
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
@@ -95,11 +238,73 @@ func (u *QuizUsecase) UpdateQuiz(ctx context.Context, q *Quiz) (*Quiz, error) {
 	return res, nil
 }
 
+// DeleteQuiz deletes a quiz along with its collaborators. Only its author may.
 func (u *QuizUsecase) DeleteQuiz(ctx context.Context, id string) (*Quiz, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuizUsecase.DeleteQuiz")
 	defer span.End()
 
-	res, err := u.repo.Delete(ctx, id)
+	userID := ActorFromContext(ctx)
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to delete a quiz")
+	}
+	var res *Quiz
+	err := u.tx.InTx(ctx, func(ctx context.Context) error {
+		quiz, err := u.repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if quiz.UserID != userID {
+			return pb.ErrorForbidden("only the quiz author can delete it")
+		}
+		if _, err := u.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		if err := u.collaborators.RemoveAll(ctx, id); err != nil {
+			return err
+		}
+		res = quiz
+		if err := audit(ctx, u.audit, AuditDelete, AuditEntityQuiz, id, res.UserID, res, nil); err != nil {
+			return err
+		}
+		return record(ctx, u.outbox, EventQuizDeleted, id, res)
+	})
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *QuizUsecase) PublishQuiz(ctx context.Context, id string, userID string) (*Quiz, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.QuizUsecase.PublishQuiz")
+	defer span.End()
+
+	quiz, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to publish a quiz")
+	}
+	if quiz.UserID != userID {
+		return nil, pb.ErrorForbidden("only the quiz author can publish it")
+	}
+	if quiz.PublishedAt != "" {
+		return nil, pb.ErrorQuizAlreadyPublished("quiz was published at %s", quiz.PublishedAt)
+	}
+
+	var res *Quiz
+	err = u.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = u.repo.Publish(ctx, id, time.Now().UTC().Format(time.RFC3339)); err != nil {
+			return err
+		}
+		if err := audit(ctx, u.audit, AuditPublish, AuditEntityQuiz, id, res.UserID, quiz, res); err != nil {
+			return err
+		}
+		return record(ctx, u.outbox, EventQuizPublished, res.ID, res)
+	})
 	if err != nil {
 		u.log.Warn(err)
 		return nil, err
@@ -107,6 +312,117 @@ func (u *QuizUsecase) DeleteQuiz(ctx context.Context, id string) (*Quiz, error)
 	return res, nil
 }
 
+// CloneQuiz copies quiz id and the questions it owns into an unpublished quiz,
+// giving every question and answer a fresh ID. Bank questions stay shared by
+// reference. Anyone who can read a quiz may clone it, except premium quizzes,
+// which only their editors may copy.
+func (u *QuizUsecase) CloneQuiz(ctx context.Context, id string, overrides *CloneOverrides) (*Quiz, int, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.QuizUsecase.CloneQuiz")
+	defer span.End()
+
+	userID := ActorFromContext(ctx)
+	if userID == "" {
+		return nil, 0, pb.ErrorUnauthorized("sign in to clone a quiz")
+	}
+	source, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, 0, err
+	}
+	if err := u.gate.Authorize(ctx, userID, source); err != nil {
+		return nil, 0, err
+	}
+	owner := userID
+	if overrides.UserID != nil {
+		owner = *overrides.UserID
+	}
+	if source.IsPremium() || owner != userID {
+		if err := authorizeEdit(ctx, u.collaborators, source, userID); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	clone := *source
+	clone.ID = ""
+	clone.UserID = owner
+	clone.PublishedAt = ""
+	clone.CreatedAt, clone.UpdatedAt, clone.DeletedAt = "", "", ""
+	clone.CreatedBy, clone.UpdatedBy, clone.DeletedBy = "", "", ""
+	if overrides.Title != nil {
+		clone.Title = *overrides.Title
+	}
+	clone.Tags = append([]string(nil), source.Tags...)
+	if len(overrides.Tags) > 0 {
+		clone.Tags = overrides.Tags
+	}
+	clone.Metadata = make(map[string]string, len(source.Metadata)+1)
+	for k, v := range source.Metadata {
+		clone.Metadata[k] = v
+	}
+	clone.Metadata[MetadataClonedFrom] = source.ID
+	clone.QuestionIDs = append([]string(nil), source.QuestionIDs...)
+	clone.DrawRules = append([]DrawRule(nil), source.DrawRules...)
+	if err := validateNewQuiz(&clone); err != nil {
+		return nil, 0, err
+	}
+	if err := u.checkQuestionIDs(ctx, clone.QuestionIDs); err != nil {
+		return nil, 0, err
+	}
+
+	var res *Quiz
+	var copied int
+	err = u.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = u.repo.Save(ctx, &clone); err != nil {
+			return err
+		}
+		ids, err := u.questions.MatchIDs(ctx, &QuestionFilter{QuizID: source.ID})
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		questions, err := u.questions.ListByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, q := range questions {
+			dup := cloneQuestion(q, res.ID, userID)
+			saved, err := u.questions.Save(ctx, dup)
+			if err != nil {
+				return err
+			}
+			if err := audit(ctx, u.audit, AuditCreate, AuditEntityQuestion, saved.ID, res.UserID, nil, questionAudit(saved)); err != nil {
+				return err
+			}
+		}
+		copied = len(questions)
+		if err := audit(ctx, u.audit, AuditCreate, AuditEntityQuiz, res.ID, res.UserID, nil, res); err != nil {
+			return err
+		}
+		return record(ctx, u.outbox, EventQuizCreated, res.ID, res)
+	})
+	if err != nil {
+		u.log.Warn(err)
+		return nil, 0, err
+	}
+	return res, copied, nil
+}
+
+// cloneQuestion copies q into quizID with fresh answer IDs.
+func cloneQuestion(q *Question, quizID string, actor string) *Question {
+	res := *q
+	res.ID = ""
+	res.QuizID = quizID
+	res.Tags = append([]string(nil), q.Tags...)
+	res.Answers = make([]Answer, 0, len(q.Answers))
+	for _, a := range q.Answers {
+		a.ID = uuid.New().String()
+		res.Answers = append(res.Answers, a)
+	}
+	res.CreatedAt, res.UpdatedAt, res.DeletedAt = "", "", ""
+	res.CreatedBy, res.UpdatedBy, res.DeletedBy = actor, actor, ""
+	return &res
+}
+
 func (u *QuizUsecase) SearchQuiz(ctx context.Context, keyword string, pagination *Pagination) ([]*Quiz, error) {
 	ctx, span := u.tracer.Start(ctx, "biz.QuizUsecase.SearchQuiz")
 	defer span.End()
@@ -118,3 +434,35 @@ func (u *QuizUsecase) SearchQuiz(ctx context.Context, keyword string, pagination
 	}
 	return res, nil
 }
+
+// checkQuestionIDs requires the questions a quiz links by ID to exist in the
+// bank. Questions of another quiz cannot be linked, which would hand them out
+// past the gate of their own quiz.
+func (u *QuizUsecase) checkQuestionIDs(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	questions, err := u.questions.ListByIDs(ctx, ids)
+	if err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	bank := make(map[string]bool, len(questions))
+	for _, q := range questions {
+		bank[q.ID] = q.QuizID == ""
+	}
+	fields := make(map[string]string)
+	for i, id := range ids {
+		inBank, ok := bank[id]
+		switch {
+		case !ok:
+			fields[fmt.Sprintf("question_ids[%d]", i)] = "no such question"
+		case !inBank:
+			fields[fmt.Sprintf("question_ids[%d]", i)] = "belongs to a quiz; only bank questions can be linked"
+		}
+	}
+	if len(fields) > 0 {
+		return FieldErrors(fields)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"quiz/internal/conf"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+var (
+	defaultRouteLimit      = Limit{Rate: 10, Burst: 20}
+	defaultValidationLimit = Limit{Rate: 10 / (10 * time.Minute).Seconds(), Burst: 10}
+	defaultRebuildLimit    = Limit{Rate: 2 / (10 * time.Minute).Seconds(), Burst: 2}
+)
+
+// Limit is a token bucket: Burst requests at once, refilled at Rate tokens
+// per second.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimitStore keeps token buckets by key.
+type RateLimitStore interface {
+	// Take removes a token from the bucket of key, creating a full one when
+	// there is none. Without a token left it returns false along with how
+	// long until the next one.
+	Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
+
+// Caller is who a request is counted against. IP is the address the request
+// came from, which unlike UserID the caller cannot choose.
+type Caller struct {
+	UserID string
+	IP     string
+}
+
+// keys returns the buckets the caller takes from: one per address and one
+// per user, so that switching users does not escape the limit.
+func (c Caller) keys() []string {
+	var keys []string
+	if c.IP != "" {
+		keys = append(keys, "ip:"+c.IP)
+	}
+	if c.UserID != "" {
+		keys = append(keys, "user:"+c.UserID)
+	}
+	return keys
+}
+
+type callerKey struct{}
+
+// WithCaller returns a context carrying who the request is counted against.
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns who the request is counted against, which is
+// empty when unknown.
+func CallerFromContext(ctx context.Context) Caller {
+	caller, _ := ctx.Value(callerKey{}).(Caller)
+	return caller
+}
+
+// RateLimiter throttles callers per route and caps how often one caller may
+// check the answers of a question, which would otherwise give the answer key
+// away to a script trying every combination. It also caps how often the
+// leaderboard of a quiz is rebuilt, each rebuild reading all its attempts.
+type RateLimiter struct {
+	store          RateLimitStore
+	enabled        bool
+	fallback       Limit
+	routes         map[string]Limit
+	validation     Limit
+	rebuild        Limit
+	clientIPHeader string
+	log            *log.Helper
+}
+
+func NewRateLimiter(bc *conf.Bootstrap, store RateLimitStore, logger log.Logger) *RateLimiter {
+	c := bc.GetRateLimit()
+	l := &RateLimiter{
+		store:          store,
+		enabled:        c.GetEnabled(),
+		fallback:       defaultRouteLimit,
+		routes:         make(map[string]Limit),
+		validation:     defaultValidationLimit,
+		rebuild:        defaultRebuildLimit,
+		clientIPHeader: c.GetClientIpHeader(),
+		log:            log.NewHelper(logger),
+	}
+	if b := c.GetDefaultBucket(); b.GetRate() > 0 && b.GetBurst() > 0 {
+		l.fallback = Limit{Rate: b.GetRate(), Burst: int(b.GetBurst())}
+	}
+	for _, r := range c.GetRoutes() {
+		if b := r.GetBucket(); b.GetRate() > 0 && b.GetBurst() > 0 {
+			l.routes[r.GetOperation()] = Limit{Rate: b.GetRate(), Burst: int(b.GetBurst())}
+		}
+	}
+	if v := c.GetQuestionValidation(); v.GetMaxAttempts() > 0 && v.GetWindow().AsDuration() > 0 {
+		l.validation = Limit{
+			Rate:  float64(v.GetMaxAttempts()) / v.GetWindow().AsDuration().Seconds(),
+			Burst: int(v.GetMaxAttempts()),
+		}
+	}
+	if v := c.GetLeaderboardRebuild(); v.GetMaxAttempts() > 0 && v.GetWindow().AsDuration() > 0 {
+		l.rebuild = Limit{
+			Rate:  float64(v.GetMaxAttempts()) / v.GetWindow().AsDuration().Seconds(),
+			Burst: int(v.GetMaxAttempts()),
+		}
+	}
+	return l
+}
+
+// ClientIPHeader names the header a proxy puts the client address in, or ""
+// to go by the peer address.
+func (l *RateLimiter) ClientIPHeader() string {
+	return l.clientIPHeader
+}
+
+// AllowRoute takes a token for caller on operation when route limits are on.
+// operation is the full name of an RPC or the path of a plain HTTP handler.
+func (l *RateLimiter) AllowRoute(ctx context.Context, caller Caller, operation string) error {
+	if !l.enabled {
+		return nil
+	}
+	limit, ok := l.routes[operation]
+	if !ok {
+		limit = l.fallback
+	}
+	for _, key := range caller.keys() {
+		if err := l.take(ctx, "route:"+operation+":"+key, limit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AllowValidation takes a token for the caller in ctx on questionID.
+func (l *RateLimiter) AllowValidation(ctx context.Context, questionID string) error {
+	for _, key := range CallerFromContext(ctx).keys() {
+		if err := l.take(ctx, "validate:"+questionID+":"+key, l.validation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AllowRebuild takes a token for rebuilding the leaderboard of quizID.
+func (l *RateLimiter) AllowRebuild(ctx context.Context, quizID string) error {
+	return l.take(ctx, "rebuild:"+quizID, l.rebuild)
+}
+
+// take lets the request through when the store fails, so that an outage of
+// Redis does not take the service down with it.
+func (l *RateLimiter) take(ctx context.Context, key string, limit Limit) error {
+	ok, wait, err := l.store.Take(ctx, key, limit)
+	if err != nil {
+		l.log.Warnf("rate limit store failed, letting %s through: %v", key, err)
+		return nil
+	}
+	if ok {
+		return nil
+	}
+	seconds := int64(math.Ceil(wait.Seconds()))
+	return pb.ErrorTooManyRequests("rate limit exceeded, retry in %ds", seconds).
+		WithMetadata(map[string]string{"retry_after": strconv.FormatInt(seconds, 10)})
+}
@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+// QuizStats sums up the submitted attempts of one user at one quiz.
+type QuizStats struct {
+	QuizID        string        `json:"quiz_id"`
+	UserID        string        `json:"user_id"`
+	Attempts      int64         `json:"attempts"`
+	BestScore     float32       `json:"best_score"`
+	LatestScore   float32       `json:"latest_score"`
+	AverageScore  float32       `json:"average_score"`
+	TimeSpent     time.Duration `json:"time_spent"`
+	LastAttemptAt time.Time     `json:"last_attempt_at"`
+}
+
+// TimeSpent returns how long a submitted attempt took.
+func (a *Attempt) TimeSpent() (time.Duration, bool) {
+	if a.SubmittedAt == nil {
+		return 0, false
+	}
+	return a.SubmittedAt.Sub(a.StartedAt), true
+}
+
+type ResultsRepo interface {
+	// ListAttempts returns the attempts of a user, newest first, optionally of one quiz only.
+	ListAttempts(ctx context.Context, userID string, quizID string, pagination *Pagination) ([]*Attempt, error)
+	// StatsByQuiz returns one entry per quiz the user submitted, most recent first.
+	StatsByQuiz(ctx context.Context, userID string, pagination *Pagination) ([]*QuizStats, error)
+	// StatsByUser returns one entry per user who submitted the quiz, best score first.
+	StatsByUser(ctx context.Context, quizID string, pagination *Pagination) ([]*QuizStats, error)
+	ItemStats(ctx context.Context, quizID string) (*QuizItemStats, error)
+}
+
+type ResultsUsecase struct {
+	repo      ResultsRepo
+	quizzes   QuizRepo
+	questions QuestionsRepo
+	log       *log.Helper
+	tracer    trace.Tracer
+}
+
+func NewResultsUsecase(repo ResultsRepo, quizzes QuizRepo, questions QuestionsRepo, logger log.Logger, tracer trace.Tracer) *ResultsUsecase {
+	return &ResultsUsecase{
+		repo:      repo,
+		quizzes:   quizzes,
+		questions: questions,
+		log:       log.NewHelper(logger),
+		tracer:    tracer,
+	}
+}
+
+func (u *ResultsUsecase) ListUserAttempts(ctx context.Context, userID string, callerID string, quizID string, pagination *Pagination) ([]*Attempt, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.ResultsUsecase.ListUserAttempts")
+	defer span.End()
+	span.SetAttributes(attribute.String("user_id", userID), attribute.String("quiz_id", quizID))
+
+	if err := authorizeOwnResults(userID, callerID); err != nil {
+		return nil, err
+	}
+	res, err := u.repo.ListAttempts(ctx, userID, quizID, pagination)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *ResultsUsecase) GetUserStats(ctx context.Context, userID string, callerID string, pagination *Pagination) ([]*QuizStats, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.ResultsUsecase.GetUserStats")
+	defer span.End()
+	span.SetAttributes(attribute.String("user_id", userID))
+
+	if err := authorizeOwnResults(userID, callerID); err != nil {
+		return nil, err
+	}
+	res, err := u.repo.StatsByQuiz(ctx, userID, pagination)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetQuizResults reports every taker of a quiz to the quiz author.
+func (u *ResultsUsecase) GetQuizResults(ctx context.Context, quizID string, callerID string, pagination *Pagination) ([]*QuizStats, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.ResultsUsecase.GetQuizResults")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", quizID))
+
+	if err := u.authorizeAuthor(ctx, quizID, callerID); err != nil {
+		return nil, err
+	}
+	res, err := u.repo.StatsByUser(ctx, quizID, pagination)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// authorizeAuthor lets only the author of quizID through.
+func (u *ResultsUsecase) authorizeAuthor(ctx context.Context, quizID string, callerID string) error {
+	quiz, err := u.quizzes.GetByID(ctx, quizID)
+	if err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	if callerID == "" {
+		return pb.ErrorUnauthorized("sign in to see quiz results")
+	}
+	if quiz.UserID != callerID {
+		return pb.ErrorForbidden("only the quiz author can see its results")
+	}
+	return nil
+}
+
+func authorizeOwnResults(userID string, callerID string) error {
+	if callerID == "" {
+		return pb.ErrorUnauthorized("sign in to see results")
+	}
+	if userID != callerID {
+		return pb.ErrorForbidden("results belong to another user")
+	}
+	return nil
+}
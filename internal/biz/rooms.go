@@ -0,0 +1,522 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	RoomEventLobby    = "lobby"
+	RoomEventQuestion = "question"
+	RoomEventAnswered = "answered"
+	RoomEventReveal   = "reveal"
+	RoomEventFinished = "finished"
+	RoomEventError    = "error"
+
+	RoomActionJoin   = "join"
+	RoomActionLeave  = "leave"
+	RoomActionNext   = "next"
+	RoomActionAnswer = "answer"
+	RoomActionClose  = "close"
+
+	defaultRoomCountdown = 20 * time.Second
+	maxRoomCountdown     = 5 * time.Minute
+	// a room closes by itself after this long, finished or not
+	roomLifetime = 4 * time.Hour
+	// points for a correct answer given the moment the question opens; an
+	// answer given at the deadline earns half
+	roomMaxPoints = 1000
+	// events a subscriber may have queued before it is cut off
+	roomQueue = 64
+)
+
+// Room is a live session of a quiz, owned by the instance that opened it.
+type Room struct {
+	PIN       string        `json:"pin"`
+	QuizID    string        `json:"quiz_id"`
+	HostID    string        `json:"host_id"`
+	Countdown time.Duration `json:"countdown"`
+	Questions int           `json:"questions"`
+}
+
+type RoomPlayer struct {
+	UserID    string `json:"user_id"`
+	Name      string `json:"name"`
+	Score     int64  `json:"score"`
+	Connected bool   `json:"connected"`
+}
+
+type RoomScore struct {
+	Rank   int    `json:"rank"`
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+	Score  int64  `json:"score"`
+	// Gained is what the last question earned.
+	Gained int64 `json:"gained"`
+}
+
+type RoomQuestion struct {
+	Index    int       `json:"index"`
+	Total    int       `json:"total"`
+	Question string    `json:"question"`
+	Answers  []Answer  `json:"answers"`
+	Deadline time.Time `json:"deadline"`
+}
+
+// RoomEvent is sent from a room to its clients. Events with To set are meant
+// for that user only.
+type RoomEvent struct {
+	Type     string        `json:"type"`
+	To       string        `json:"to,omitempty"`
+	Players  []RoomPlayer  `json:"players,omitempty"`
+	Question *RoomQuestion `json:"question,omitempty"`
+	Correct  []string      `json:"correct,omitempty"`
+	Scores   []RoomScore   `json:"scores,omitempty"`
+	Message  string        `json:"message,omitempty"`
+}
+
+// RoomAction is sent from a client to the room.
+type RoomAction struct {
+	Type      string   `json:"type"`
+	UserID    string   `json:"user_id"`
+	Name      string   `json:"name,omitempty"`
+	AnswerIDs []string `json:"answer_ids,omitempty"`
+}
+
+// RoomBroker carries room traffic between the owning instance and the
+// instances holding the client connections.
+type RoomBroker interface {
+	// Reserve claims pin for a new room, reporting false when it is taken.
+	Reserve(ctx context.Context, pin string, ttl time.Duration) (bool, error)
+	Release(ctx context.Context, pin string) error
+	Exists(ctx context.Context, pin string) (bool, error)
+	Publish(ctx context.Context, topic string, msg []byte) error
+	// Subscribe delivers the messages of topic until ctx is done.
+	Subscribe(ctx context.Context, topic string) (<-chan []byte, error)
+}
+
+func roomEventsTopic(pin string) string  { return "room:" + pin + ":events" }
+func roomActionsTopic(pin string) string { return "room:" + pin + ":actions" }
+
+type RoomsUsecase struct {
+	broker   RoomBroker
+	quizzes  QuizRepo
+	attempts *AttemptsUsecase
+	gate     *PremiumGate
+	log      *log.Helper
+	tracer   trace.Tracer
+}
+
+func NewRoomsUsecase(broker RoomBroker, quizzes QuizRepo, attempts *AttemptsUsecase, gate *PremiumGate, logger log.Logger, tracer trace.Tracer) *RoomsUsecase {
+	return &RoomsUsecase{
+		broker:   broker,
+		quizzes:  quizzes,
+		attempts: attempts,
+		gate:     gate,
+		log:      log.NewHelper(logger),
+		tracer:   tracer,
+	}
+}
+
+// CreateRoom draws the questions of a quiz the same way attempts do and opens
+// a room for them on this instance.
+func (u *RoomsUsecase) CreateRoom(ctx context.Context, quizID string, hostID string, countdown time.Duration) (*Room, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.RoomsUsecase.CreateRoom")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", quizID), attribute.String("host_id", hostID))
+
+	if hostID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to host a room")
+	}
+	if countdown == 0 {
+		countdown = defaultRoomCountdown
+	}
+	if countdown < time.Second || countdown > maxRoomCountdown {
+		return nil, pb.ErrorInvalidArgument("countdown must be between 1s and %s", maxRoomCountdown)
+	}
+	quiz, err := u.quizzes.GetByID(ctx, quizID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if err := u.gate.Authorize(ctx, hostID, quiz); err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewSource(rand.Int63()))
+	ids, err := u.attempts.drawQuestions(ctx, quiz, rng)
+	if err != nil {
+		return nil, err
+	}
+	if quiz.Shuffle != nil && quiz.Shuffle.Questions {
+		rng.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+	}
+	questions, err := u.attempts.attemptQuestions(ctx, &Attempt{QuestionIDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	if len(questions) == 0 {
+		return nil, pb.ErrorInvalidArgument("quiz has no questions")
+	}
+	if quiz.Shuffle != nil && quiz.Shuffle.Answers {
+		for _, q := range questions {
+			q.Answers = orderAnswers(q.Answers, shuffleAnswers(rng, q.Answers))
+		}
+	}
+
+	room := &Room{QuizID: quiz.ID, HostID: hostID, Countdown: countdown, Questions: len(questions)}
+	for i := 0; i < 10 && room.PIN == ""; i++ {
+		pin := fmt.Sprintf("%06d", rand.Intn(1000000))
+		ok, err := u.broker.Reserve(ctx, pin, roomLifetime)
+		if err != nil {
+			u.log.Warn(err)
+			return nil, err
+		}
+		if ok {
+			room.PIN = pin
+		}
+	}
+	if room.PIN == "" {
+		return nil, pb.ErrorUnavailable("could not find a free PIN, try again")
+	}
+	span.SetAttributes(attribute.String("pin", room.PIN))
+
+	// the room outlives the request that opened it
+	runCtx, cancel := context.WithTimeout(context.Background(), roomLifetime)
+	actions, err := u.broker.Subscribe(runCtx, roomActionsTopic(room.PIN))
+	if err != nil {
+		cancel()
+		u.log.Warn(err)
+		_ = u.broker.Release(ctx, room.PIN)
+		return nil, err
+	}
+	s := &roomState{
+		u:         u,
+		room:      room,
+		questions: questions,
+		players:   make(map[string]*RoomPlayer),
+		current:   -1,
+	}
+	go s.run(runCtx, cancel, actions)
+	return room, nil
+}
+
+// RoomSubscription delivers the events of a room meant for one user.
+type RoomSubscription struct {
+	// C closes when the subscription context is done, the room is over or
+	// the consumer fell too far behind.
+	C   <-chan *RoomEvent
+	err error
+}
+
+// Err tells why C was closed. It is only meaningful once C is closed.
+func (s *RoomSubscription) Err() error {
+	return s.err
+}
+
+// Subscribe delivers the events of a room meant for userID. A consumer that
+// falls roomQueue events behind is cut off with a ResourceExhausted error
+// rather than silently missing events; it can subscribe and join again to
+// pick the room up where it is.
+func (u *RoomsUsecase) Subscribe(ctx context.Context, pin string, userID string) (*RoomSubscription, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.RoomsUsecase.Subscribe")
+	defer span.End()
+	span.SetAttributes(attribute.String("pin", pin), attribute.String("user_id", userID))
+
+	if userID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to join a room")
+	}
+	ok, err := u.broker.Exists(ctx, pin)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if !ok {
+		return nil, pb.ErrorRoomNotFound("no room with PIN %s", pin)
+	}
+	raw, err := u.broker.Subscribe(ctx, roomEventsTopic(pin))
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	events := make(chan *RoomEvent, roomQueue)
+	sub := &RoomSubscription{C: events}
+	go func() {
+		defer close(events)
+		for msg := range raw {
+			var e RoomEvent
+			if err := json.Unmarshal(msg, &e); err != nil {
+				u.log.Warn(err)
+				continue
+			}
+			if e.To != "" && e.To != userID {
+				continue
+			}
+			select {
+			case events <- &e:
+			default:
+				sub.err = pb.ErrorTooManyRequests("fell behind the events of room %s", pin)
+				return
+			}
+			if e.Type == RoomEventFinished {
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// Join enters userID into a room, or reconnects them, under name.
+func (u *RoomsUsecase) Join(ctx context.Context, pin string, userID string, name string) error {
+	if userID == "" {
+		return pb.ErrorUnauthorized("sign in to join a room")
+	}
+	return u.Act(ctx, pin, &RoomAction{Type: RoomActionJoin, UserID: userID, Name: name})
+}
+
+// Act forwards a client action to the instance that owns the room.
+func (u *RoomsUsecase) Act(ctx context.Context, pin string, action *RoomAction) error {
+	msg, err := json.Marshal(action)
+	if err != nil {
+		return err
+	}
+	if err := u.broker.Publish(ctx, roomActionsTopic(pin), msg); err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	return nil
+}
+
+// roomState is only touched by the run goroutine of its room.
+type roomState struct {
+	u         *RoomsUsecase
+	room      *Room
+	questions []*Question
+	players   map[string]*RoomPlayer
+	joined    []string
+	current   int
+	open      bool
+	deadline  time.Time
+	timer     *time.Timer
+	gained    map[string]int64
+}
+
+func (s *roomState) run(ctx context.Context, cancel context.CancelFunc, actions <-chan []byte) {
+	defer cancel()
+	defer func() {
+		if err := s.u.broker.Release(context.Background(), s.room.PIN); err != nil {
+			s.u.log.Warn(err)
+		}
+	}()
+	for {
+		var expired <-chan time.Time
+		if s.open {
+			expired = s.timer.C
+		}
+		select {
+		case <-ctx.Done():
+			s.finish()
+			return
+		case <-expired:
+			s.reveal()
+		case msg, ok := <-actions:
+			if !ok {
+				s.finish()
+				return
+			}
+			var a RoomAction
+			if err := json.Unmarshal(msg, &a); err != nil {
+				s.u.log.Warn(err)
+				continue
+			}
+			if done := s.handle(&a); done {
+				return
+			}
+		}
+	}
+}
+
+// handle applies one action, reporting whether the room is over.
+func (s *roomState) handle(a *RoomAction) bool {
+	host := a.UserID == s.room.HostID
+	switch a.Type {
+	case RoomActionJoin:
+		if !host {
+			p, ok := s.players[a.UserID]
+			if !ok {
+				p = &RoomPlayer{UserID: a.UserID}
+				s.players[a.UserID] = p
+				s.joined = append(s.joined, a.UserID)
+			}
+			p.Connected = true
+			if a.Name != "" {
+				p.Name = a.Name
+			}
+		}
+		s.publish(&RoomEvent{Type: RoomEventLobby, Players: s.playerList()})
+		if s.open {
+			s.publish(&RoomEvent{Type: RoomEventQuestion, To: a.UserID, Question: s.question()})
+		}
+	case RoomActionLeave:
+		if p, ok := s.players[a.UserID]; ok {
+			p.Connected = false
+			s.publish(&RoomEvent{Type: RoomEventLobby, Players: s.playerList()})
+		}
+	case RoomActionNext:
+		if !host {
+			s.reject(a.UserID, "only the host can advance the room")
+			return false
+		}
+		if s.open {
+			s.reveal()
+			return false
+		}
+		if s.current+1 >= len(s.questions) {
+			s.finish()
+			return true
+		}
+		s.ask()
+	case RoomActionAnswer:
+		s.answer(a)
+	case RoomActionClose:
+		if !host {
+			s.reject(a.UserID, "only the host can close the room")
+			return false
+		}
+		s.finish()
+		return true
+	default:
+		s.reject(a.UserID, "unknown action "+a.Type)
+	}
+	return false
+}
+
+func (s *roomState) ask() {
+	s.current++
+	s.open = true
+	s.deadline = time.Now().Add(s.room.Countdown)
+	s.timer = time.NewTimer(s.room.Countdown)
+	s.gained = make(map[string]int64)
+	s.publish(&RoomEvent{Type: RoomEventQuestion, Question: s.question()})
+}
+
+func (s *roomState) answer(a *RoomAction) {
+	p, ok := s.players[a.UserID]
+	switch {
+	case !ok:
+		s.reject(a.UserID, "join the room before answering")
+		return
+	case !s.open:
+		s.reject(a.UserID, "no question is open")
+		return
+	}
+	if _, answered := s.gained[a.UserID]; answered {
+		s.reject(a.UserID, "already answered")
+		return
+	}
+	remaining := time.Until(s.deadline)
+	if remaining < 0 {
+		remaining = 0
+	}
+	answers := make([]AttemptAnswer, 0, len(a.AnswerIDs))
+	for _, id := range a.AnswerIDs {
+		answers = append(answers, AttemptAnswer{AnswerID: id, Checked: true})
+	}
+	score, _ := gradeResponse(s.questions[s.current], answers)
+	speed := 0.5 + 0.5*float64(remaining)/float64(s.room.Countdown)
+	points := int64(math.Round(roomMaxPoints * float64(score) / 100 * speed))
+	s.gained[a.UserID] = points
+	p.Score += points
+	s.publish(&RoomEvent{Type: RoomEventAnswered, To: a.UserID})
+
+	for _, p := range s.players {
+		if _, answered := s.gained[p.UserID]; p.Connected && !answered {
+			return
+		}
+	}
+	s.reveal()
+}
+
+// reveal closes the open question and shows the answer key and the scores.
+func (s *roomState) reveal() {
+	s.open = false
+	s.timer.Stop()
+	var correct []string
+	for _, a := range s.questions[s.current].Answers {
+		if a.IsCorrect() {
+			correct = append(correct, a.ID)
+		}
+	}
+	s.publish(&RoomEvent{Type: RoomEventReveal, Correct: correct, Scores: s.scores()})
+}
+
+func (s *roomState) finish() {
+	if s.open {
+		s.reveal()
+	}
+	s.publish(&RoomEvent{Type: RoomEventFinished, Scores: s.scores()})
+}
+
+func (s *roomState) reject(userID string, message string) {
+	s.publish(&RoomEvent{Type: RoomEventError, To: userID, Message: message})
+}
+
+func (s *roomState) publish(e *RoomEvent) {
+	msg, err := json.Marshal(e)
+	if err != nil {
+		s.u.log.Warn(err)
+		return
+	}
+	if err := s.u.broker.Publish(context.Background(), roomEventsTopic(s.room.PIN), msg); err != nil {
+		s.u.log.Warn(err)
+	}
+}
+
+// question returns the open question without its answer key.
+func (s *roomState) question() *RoomQuestion {
+	q := s.questions[s.current]
+	answers := make([]Answer, 0, len(q.Answers))
+	for _, a := range q.Answers {
+		answers = append(answers, Answer{ID: a.ID, Text: a.Text})
+	}
+	return &RoomQuestion{
+		Index:    s.current,
+		Total:    len(s.questions),
+		Question: q.Question,
+		Answers:  answers,
+		Deadline: s.deadline,
+	}
+}
+
+func (s *roomState) playerList() []RoomPlayer {
+	res := make([]RoomPlayer, 0, len(s.joined))
+	for _, id := range s.joined {
+		res = append(res, *s.players[id])
+	}
+	return res
+}
+
+// scores ranks the players by score, earlier joiners first on a tie.
+func (s *roomState) scores() []RoomScore {
+	res := make([]RoomScore, 0, len(s.joined))
+	for _, id := range s.joined {
+		p := s.players[id]
+		res = append(res, RoomScore{UserID: p.UserID, Name: p.Name, Score: p.Score, Gained: s.gained[id]})
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Score > res[j].Score })
+	for i := range res {
+		res[i].Rank = i + 1
+	}
+	return res
+}
@@ -3,10 +3,11 @@ package biz
 import (
 	"context"
 	"fmt"
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+
+	pb "quiz/api/quizzes/v1"
 )
 
 type User struct {
@@ -55,7 +56,7 @@ func (uc *UsersUsecase) CreateUser(ctx context.Context, u *User) (string, error)
 		return "", err
 	}
 	if res == "" {
-		return "", errors.InternalServer("failed to save user", "err was empty but insertions failed")
+		return "", pb.ErrorInternal("failed to save user")
 	}
 	return res, nil
 }
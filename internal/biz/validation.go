@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	MaxQuizTitleLength    = 200
+	MaxQuestionTextLength = 1000
+	MaxAnswerTextLength   = 500
+	MaxAnswersPerQuestion = 20
+)
+
+// FieldErrors builds a VALIDATION_FAILED error whose metadata maps each invalid
+// field to what is wrong with it.
+func FieldErrors(fields map[string]string) *errors.Error {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return pb.ErrorValidationFailed("invalid fields: %s", strings.Join(names, ", ")).WithMetadata(fields)
+}
+
+func validateQuiz(q *Quiz) error {
+	fields := make(map[string]string)
+	if n := utf8.RuneCountInString(strings.TrimSpace(q.Title)); n > MaxQuizTitleLength {
+		fields["title"] = "must be at most 200 characters"
+	}
+	for i, r := range q.DrawRules {
+		if r.Count == 0 {
+			fields[fmt.Sprintf("draw_rules[%d].count", i)] = "must be positive"
+		}
+	}
+	if q.Adaptive != nil && q.Adaptive.Length == 0 {
+		fields["adaptive.length"] = "must be positive"
+	}
+	if len(fields) > 0 {
+		return FieldErrors(fields)
+	}
+	return nil
+}
+
+// validateNewQuiz also requires the fields a quiz cannot be created without.
+func validateNewQuiz(q *Quiz) error {
+	if strings.TrimSpace(q.Title) == "" {
+		return FieldErrors(map[string]string{"title": "must not be empty"})
+	}
+	return validateQuiz(q)
+}
+
+func validateQuestionText(text string) error {
+	n := utf8.RuneCountInString(strings.TrimSpace(text))
+	switch {
+	case n == 0:
+		return FieldErrors(map[string]string{"question": "must not be empty"})
+	case n > MaxQuestionTextLength:
+		return FieldErrors(map[string]string{"question": "must be at most 1000 characters"})
+	}
+	return nil
+}
+
+// validateAnswerSet checks the invariants every stored question keeps: at
+// least one correct answer and no two answers reading the same.
+func validateAnswerSet(answers []Answer) error {
+	fields := make(map[string]string)
+	if len(answers) == 0 {
+		fields["answers"] = "must have at least one answer"
+	}
+	if len(answers) > MaxAnswersPerQuestion {
+		fields["answers"] = "must have at most 20 answers"
+	}
+	correct := 0
+	seen := make(map[string]int, len(answers))
+	for i, a := range answers {
+		text := strings.TrimSpace(a.Text)
+		switch n := utf8.RuneCountInString(text); {
+		case n == 0:
+			fields[fmt.Sprintf("answers[%d].text", i)] = "must not be empty"
+		case n > MaxAnswerTextLength:
+			fields[fmt.Sprintf("answers[%d].text", i)] = "must be at most 500 characters"
+		}
+		key := strings.ToLower(text)
+		if j, ok := seen[key]; ok && text != "" {
+			fields[fmt.Sprintf("answers[%d].text", i)] = fmt.Sprintf("duplicates answers[%d]", j)
+		} else {
+			seen[key] = i
+		}
+		if a.isCorrect {
+			correct++
+		}
+	}
+	if len(answers) > 0 && correct == 0 {
+		fields["answers"] = "must have at least one correct answer"
+	}
+	if len(fields) > 0 {
+		return FieldErrors(fields)
+	}
+	return nil
+}
+
+func validateAssignment(a *Assignment) error {
+	fields := make(map[string]string)
+	if a.ClosesAt != nil && !a.ClosesAt.After(a.OpensAt) {
+		fields["closes_at"] = "must be after opens_at"
+	}
+	if a.DueAt != nil {
+		if a.DueAt.Before(a.OpensAt) {
+			fields["due_at"] = "must not be before opens_at"
+		} else if a.ClosesAt != nil && a.DueAt.After(*a.ClosesAt) {
+			fields["due_at"] = "must not be after closes_at"
+		}
+	}
+	if len(fields) > 0 {
+		return FieldErrors(fields)
+	}
+	return nil
+}
@@ -0,0 +1,501 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	mrand "math/rand"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/conf"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type WebhookStatus int32
+
+const (
+	WebhookPending WebhookStatus = iota
+	WebhookSucceeded
+	WebhookRetrying
+	WebhookDead
+)
+
+const (
+	defaultWebhookMaxAttempts  = 8
+	defaultWebhookTimeout      = 10 * time.Second
+	defaultWebhookPollInterval = 5 * time.Second
+	webhookBatchSize           = 20
+	webhookFirstRetry          = 30 * time.Second
+	webhookMaxRetry            = 6 * time.Hour
+)
+
+// webhookEvents are the event types a webhook can subscribe to.
+var webhookEvents = map[string]bool{
+	EventQuizCreated:      true,
+	EventQuizUpdated:      true,
+	EventQuizPublished:    true,
+	EventAttemptSubmitted: true,
+}
+
+// Webhook subscribes a URL of a quiz author to events about their quizzes.
+type Webhook struct {
+	ID        string    `json:"id"`
+	OwnerID   string    `json:"owner_id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookDelivery is one event on its way to one webhook.
+type WebhookDelivery struct {
+	ID             string
+	WebhookID      string
+	OwnerID        string
+	EventID        string
+	EventType      string
+	Payload        []byte
+	Status         WebhookStatus
+	Attempts       uint32
+	LastStatusCode int
+	LastError      string
+	NextAttemptAt  *time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+type WebhooksRepo interface {
+	Save(ctx context.Context, w *Webhook) (*Webhook, error)
+	GetByID(ctx context.Context, id string) (*Webhook, error)
+	List(ctx context.Context, ownerID string) ([]*Webhook, error)
+	Delete(ctx context.Context, id string) error
+	// Subscribed returns the webhooks of ownerID that want eventType.
+	Subscribed(ctx context.Context, ownerID string, eventType string) ([]*Webhook, error)
+}
+
+type WebhookDeliveriesRepo interface {
+	// Enqueue stores new deliveries, skipping the ones already stored for the
+	// same webhook and event.
+	Enqueue(ctx context.Context, deliveries []*WebhookDelivery) error
+	// Claim leases up to limit deliveries due at now for lease, so other
+	// instances leave them alone while they are being sent.
+	Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*WebhookDelivery, error)
+	Update(ctx context.Context, d *WebhookDelivery) (*WebhookDelivery, error)
+	GetByID(ctx context.Context, id string) (*WebhookDelivery, error)
+	List(ctx context.Context, webhookID string, status *WebhookStatus, pagination *Pagination) ([]*WebhookDelivery, error)
+}
+
+// WebhookSender POSTs a delivery, returning the response status code.
+type WebhookSender interface {
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)
+}
+
+type WebhooksUsecase struct {
+	repo         WebhooksRepo
+	deliveries   WebhookDeliveriesRepo
+	sender       WebhookSender
+	quizzes      QuizRepo
+	maxAttempts  uint32
+	timeout      time.Duration
+	pollInterval time.Duration
+	log          *log.Helper
+	tracer       trace.Tracer
+}
+
+func NewWebhooksUsecase(bc *conf.Bootstrap, repo WebhooksRepo, deliveries WebhookDeliveriesRepo, sender WebhookSender, quizzes QuizRepo, logger log.Logger, tracer trace.Tracer) *WebhooksUsecase {
+	u := &WebhooksUsecase{
+		repo:         repo,
+		deliveries:   deliveries,
+		sender:       sender,
+		quizzes:      quizzes,
+		maxAttempts:  defaultWebhookMaxAttempts,
+		timeout:      defaultWebhookTimeout,
+		pollInterval: defaultWebhookPollInterval,
+		log:          log.NewHelper(logger),
+		tracer:       tracer,
+	}
+	if n := bc.GetWebhooks().GetMaxAttempts(); n > 0 {
+		u.maxAttempts = n
+	}
+	if t := bc.GetWebhooks().GetTimeout(); t != nil {
+		u.timeout = t.AsDuration()
+	}
+	if i := bc.GetWebhooks().GetPollInterval(); i != nil {
+		u.pollInterval = i.AsDuration()
+	}
+	return u
+}
+
+// PublicAddr reports whether ip may receive webhooks: anything but loopback,
+// private, link-local, unspecified and other special-purpose addresses,
+// which would let a subscriber reach services inside our network.
+func PublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() && ip.IsGlobalUnicast() && !ip.IsPrivate() &&
+		!ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsUnspecified() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+// sharedAddressSpace is carrier-grade NAT, private in all but name.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// CreateWebhook subscribes rawURL to events, generating a secret when none is given.
+func (u *WebhooksUsecase) CreateWebhook(ctx context.Context, ownerID string, rawURL string, events []string, secret string) (*Webhook, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.WebhooksUsecase.CreateWebhook")
+	defer span.End()
+
+	if ownerID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to manage webhooks")
+	}
+	target, err := url.Parse(rawURL)
+	if err != nil || target.Scheme != "https" || target.Hostname() == "" || target.User != nil {
+		return nil, pb.ErrorInvalidArgument("webhook url must be an absolute https URL")
+	}
+	// names are checked again on every delivery, when they are resolved
+	host := strings.ToLower(strings.TrimSuffix(target.Hostname(), "."))
+	if ip, err := netip.ParseAddr(host); (err == nil && !PublicAddr(ip)) || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return nil, pb.ErrorInvalidArgument("webhook url must point to a public host")
+	}
+	if len(events) == 0 {
+		return nil, pb.ErrorInvalidArgument("subscribe to at least one event")
+	}
+	for _, e := range events {
+		if !webhookEvents[e] {
+			return nil, pb.ErrorInvalidArgument("unknown event %s", e)
+		}
+	}
+	if secret == "" {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		secret = hex.EncodeToString(b)
+	}
+
+	res, err := u.repo.Save(ctx, &Webhook{
+		OwnerID:   ownerID,
+		URL:       target.String(),
+		Events:    events,
+		Secret:    secret,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *WebhooksUsecase) ListWebhooks(ctx context.Context, ownerID string) ([]*Webhook, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.WebhooksUsecase.ListWebhooks")
+	defer span.End()
+
+	if ownerID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to manage webhooks")
+	}
+	res, err := u.repo.List(ctx, ownerID)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *WebhooksUsecase) DeleteWebhook(ctx context.Context, id string, ownerID string) error {
+	ctx, span := u.tracer.Start(ctx, "biz.WebhooksUsecase.DeleteWebhook")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	if _, err := u.getOwn(ctx, id, ownerID); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, id); err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	return nil
+}
+
+func (u *WebhooksUsecase) ListDeliveries(ctx context.Context, webhookID string, ownerID string, status *WebhookStatus, pagination *Pagination) ([]*WebhookDelivery, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.WebhooksUsecase.ListDeliveries")
+	defer span.End()
+	span.SetAttributes(attribute.String("webhook_id", webhookID))
+
+	if _, err := u.getOwn(ctx, webhookID, ownerID); err != nil {
+		return nil, err
+	}
+	res, err := u.deliveries.List(ctx, webhookID, status, pagination)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReplayDelivery queues a delivery again with a fresh set of attempts.
+func (u *WebhooksUsecase) ReplayDelivery(ctx context.Context, id string, ownerID string) (*WebhookDelivery, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.WebhooksUsecase.ReplayDelivery")
+	defer span.End()
+	span.SetAttributes(attribute.String("id", id))
+
+	if ownerID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to manage webhooks")
+	}
+	d, err := u.deliveries.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if d.OwnerID != ownerID {
+		return nil, pb.ErrorForbidden("delivery belongs to another user")
+	}
+	if d.Status == WebhookPending || d.Status == WebhookRetrying {
+		return nil, pb.ErrorConflict("delivery is still being attempted")
+	}
+	now := time.Now().UTC()
+	d.Status = WebhookPending
+	d.Attempts = 0
+	d.LastError = ""
+	d.LastStatusCode = 0
+	d.NextAttemptAt = &now
+	d.DeliveredAt = nil
+	res, err := u.deliveries.Update(ctx, d)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *WebhooksUsecase) getOwn(ctx context.Context, id string, ownerID string) (*Webhook, error) {
+	if ownerID == "" {
+		return nil, pb.ErrorUnauthorized("sign in to manage webhooks")
+	}
+	w, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		u.log.Warn(err)
+		return nil, err
+	}
+	if w.OwnerID != ownerID {
+		return nil, pb.ErrorForbidden("webhook belongs to another user")
+	}
+	return w, nil
+}
+
+// Enqueue queues a delivery of each event to every webhook of the author of
+// the quiz the event is about. It is safe to call again with the same events.
+func (u *WebhooksUsecase) Enqueue(ctx context.Context, events []*DomainEvent) error {
+	ctx, span := u.tracer.Start(ctx, "biz.WebhooksUsecase.Enqueue")
+	defer span.End()
+
+	var deliveries []*WebhookDelivery
+	now := time.Now().UTC()
+	for _, e := range events {
+		if !webhookEvents[e.Type] {
+			continue
+		}
+		owner, err := u.eventOwner(ctx, e)
+		if err != nil {
+			return err
+		}
+		if owner == "" {
+			continue
+		}
+		hooks, err := u.repo.Subscribed(ctx, owner, e.Type)
+		if err != nil {
+			u.log.Warn(err)
+			return err
+		}
+		if len(hooks) == 0 {
+			continue
+		}
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		for _, h := range hooks {
+			deliveries = append(deliveries, &WebhookDelivery{
+				WebhookID:     h.ID,
+				OwnerID:       owner,
+				EventID:       e.ID,
+				EventType:     e.Type,
+				Payload:       payload,
+				Status:        WebhookPending,
+				NextAttemptAt: &now,
+				CreatedAt:     now,
+			})
+		}
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	span.SetAttributes(attribute.Int("deliveries", len(deliveries)))
+	if err := u.deliveries.Enqueue(ctx, deliveries); err != nil {
+		u.log.Warn(err)
+		return err
+	}
+	return nil
+}
+
+// eventOwner returns the author of the quiz an event is about, or "" when the
+// quiz is gone.
+func (u *WebhooksUsecase) eventOwner(ctx context.Context, e *DomainEvent) (string, error) {
+	var ref struct {
+		UserID string `json:"user_id"`
+		QuizID string `json:"quiz_id"`
+	}
+	if err := json.Unmarshal(e.Data, &ref); err != nil {
+		return "", err
+	}
+	if e.Type != EventAttemptSubmitted {
+		return ref.UserID, nil
+	}
+	quiz, err := u.quizzes.GetByID(ctx, ref.QuizID)
+	if errors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		u.log.Warn(err)
+		return "", err
+	}
+	return quiz.UserID, nil
+}
+
+// Run sends due deliveries until ctx is done.
+func (u *WebhooksUsecase) Run(ctx context.Context) {
+	ticker := time.NewTicker(u.pollInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			n, err := u.dispatch(ctx)
+			if err != nil {
+				u.log.Warn(err)
+			}
+			if err != nil || n < webhookBatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (u *WebhooksUsecase) dispatch(ctx context.Context) (int, error) {
+	ctx, span := u.tracer.Start(ctx, "biz.WebhooksUsecase.dispatch")
+	defer span.End()
+
+	// the lease outlasts the slowest batch so nobody else picks it up meanwhile
+	batch, err := u.deliveries.Claim(ctx, time.Now().UTC(), 2*u.timeout, webhookBatchSize)
+	if err != nil || len(batch) == 0 {
+		return 0, err
+	}
+	span.SetAttributes(attribute.Int("deliveries", len(batch)))
+	var wg sync.WaitGroup
+	for _, d := range batch {
+		wg.Add(1)
+		go func(d *WebhookDelivery) {
+			defer wg.Done()
+			u.deliver(ctx, d)
+		}(d)
+	}
+	wg.Wait()
+	return len(batch), nil
+}
+
+func (u *WebhooksUsecase) deliver(ctx context.Context, d *WebhookDelivery) {
+	ctx, span := u.tracer.Start(ctx, "biz.WebhooksUsecase.deliver")
+	defer span.End()
+	span.SetAttributes(attribute.String("delivery_id", d.ID), attribute.String("webhook_id", d.WebhookID))
+
+	d.Attempts++
+	hook, err := u.repo.GetByID(ctx, d.WebhookID)
+	switch {
+	case errors.IsNotFound(err):
+		d.Status = WebhookDead
+		d.LastError = "webhook was deleted"
+		d.NextAttemptAt = nil
+	case err != nil:
+		u.log.Warn(err)
+		u.failed(d, 0, err)
+	default:
+		sendCtx, cancel := context.WithTimeout(ctx, u.timeout)
+		code, err := u.sender.Send(sendCtx, hook.URL, webhookHeaders(hook.Secret, d, time.Now()), d.Payload)
+		cancel()
+		if err == nil && code >= 200 && code < 300 {
+			now := time.Now().UTC()
+			d.Status = WebhookSucceeded
+			d.LastStatusCode = code
+			d.LastError = ""
+			d.NextAttemptAt = nil
+			d.DeliveredAt = &now
+		} else {
+			if err == nil {
+				err = fmt.Errorf("endpoint answered %d", code)
+			}
+			u.failed(d, code, err)
+		}
+	}
+	span.SetAttributes(attribute.Int("status", int(d.Status)), attribute.Int("attempts", int(d.Attempts)))
+	if _, err := u.deliveries.Update(ctx, d); err != nil {
+		u.log.Warn(err)
+	}
+}
+
+// failed schedules the next attempt of d, or dead-letters it once it has used
+// up its attempts.
+func (u *WebhooksUsecase) failed(d *WebhookDelivery, code int, err error) {
+	d.LastStatusCode = code
+	d.LastError = err.Error()
+	if d.Attempts >= u.maxAttempts {
+		d.Status = WebhookDead
+		d.NextAttemptAt = nil
+		return
+	}
+	next := time.Now().UTC().Add(webhookBackoff(d.Attempts))
+	d.Status = WebhookRetrying
+	d.NextAttemptAt = &next
+}
+
+// webhookBackoff doubles the wait after every failed attempt, up to
+// webhookMaxRetry, with up to 10% jitter so retries of one outage spread out.
+func webhookBackoff(attempts uint32) time.Duration {
+	wait := webhookMaxRetry
+	if shift := attempts - 1; shift < 20 {
+		wait = min(webhookFirstRetry<<shift, webhookMaxRetry)
+	}
+	return wait + time.Duration(mrand.Int63n(int64(wait/10)+1))
+}
+
+// webhookHeaders signs the payload the same way purchase notifications are
+// verified: hex HMAC-SHA256 of "<timestamp>.<body>".
+func webhookHeaders(secret string, d *WebhookDelivery, now time.Time) map[string]string {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(d.Payload)
+	return map[string]string{
+		"Content-Type":          "application/json",
+		"X-Signature":           hex.EncodeToString(mac.Sum(nil)),
+		"X-Signature-Timestamp": timestamp,
+		"X-Webhook-Event":       d.EventType,
+		"X-Webhook-Delivery":    d.ID,
+	}
+}
@@ -0,0 +1,262 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type Assignment struct {
+	ID          bson.ObjectID `bson:"_id,omitempty"`
+	QuizID      string        `bson:"quiz_id"`
+	GroupID     string        `bson:"group_id"`
+	OwnerID     string        `bson:"owner_id"`
+	OpensAt     time.Time     `bson:"opens_at"`
+	ClosesAt    *time.Time    `bson:"closes_at"`
+	DueAt       *time.Time    `bson:"due_at"`
+	MaxAttempts uint32        `bson:"max_attempts"`
+	CreatedAt   time.Time     `bson:"created_at"`
+}
+
+// AssignmentAttempts counts the attempts of a user toward an assignment, so
+// that the attempt limit can be enforced in a single update.
+type AssignmentAttempts struct {
+	AssignmentID string `bson:"assignment_id"`
+	UserID       string `bson:"user_id"`
+	Count        int64  `bson:"count"`
+}
+
+type AssignmentsRepo struct {
+	coll   *mongo.Collection
+	counts *mongo.Collection
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewAssignmentsRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.AssignmentsRepo {
+	r := &AssignmentsRepo{
+		coll:   data.mongo.Collection("assignments"),
+		counts: data.mongo.Collection("assignment_attempts"),
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+	r.ensureIndexes()
+	return r
+}
+
+func (r *AssignmentsRepo) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "group_id", Value: 1}, {Key: "quiz_id", Value: 1}}},
+		{Keys: bson.D{{Key: "quiz_id", Value: 1}}},
+		{Keys: bson.D{{Key: "owner_id", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Warnf("failed to create assignment indexes: %v", err)
+	}
+	_, err = r.counts.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "assignment_id", Value: 1}, {Key: "user_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		r.log.Warnf("failed to create assignment attempt indexes: %v", err)
+	}
+}
+
+func (r *AssignmentsRepo) Save(ctx context.Context, a *biz.Assignment) (*biz.Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AssignmentsRepo.Save", trace.WithAttributes(attribute.String("quiz_id", a.QuizID)))
+	defer span.End()
+
+	doc := AssignmentToData(a)
+	res, err := r.coll.InsertOne(ctx, doc)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	oid, err := insertedID(res)
+	if err != nil {
+		return nil, err
+	}
+	doc.ID = oid
+	return doc.Biz(), nil
+}
+
+func (r *AssignmentsRepo) GetByID(ctx context.Context, id string) (*biz.Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AssignmentsRepo.GetByID", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.decode(r.coll.FindOne(ctx, bson.M{"_id": idObj}))
+}
+
+func (r *AssignmentsRepo) List(ctx context.Context, f *biz.AssignmentFilter, pagination *biz.Pagination) ([]*biz.Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AssignmentsRepo.List")
+	defer span.End()
+
+	or := bson.A{bson.M{"group_id": bson.M{"$in": f.GroupIDs}}}
+	if f.OwnerID != "" {
+		or = append(or, bson.M{"owner_id": f.OwnerID})
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "opens_at", Value: -1}}).
+		SetSkip(int64(pagination.Page * pagination.Size)).
+		SetLimit(int64(pagination.Size))
+	return r.find(ctx, bson.M{"$or": or}, opts)
+}
+
+func (r *AssignmentsRepo) ListForQuiz(ctx context.Context, quizID string, groupIDs []string) ([]*biz.Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AssignmentsRepo.ListForQuiz", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	return r.find(ctx, bson.M{"quiz_id": quizID, "group_id": bson.M{"$in": groupIDs}}, options.Find())
+}
+
+func (r *AssignmentsRepo) IsAssigned(ctx context.Context, quizID string) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AssignmentsRepo.IsAssigned", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	n, err := r.coll.CountDocuments(ctx, bson.M{"quiz_id": quizID}, options.Count().SetLimit(1))
+	if err != nil {
+		r.log.Warn(err)
+		return false, dbError(err)
+	}
+	return n > 0, nil
+}
+
+func (r *AssignmentsRepo) TakeAttempt(ctx context.Context, assignmentID string, userID string, max uint32, used int64) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AssignmentsRepo.TakeAttempt", trace.WithAttributes(attribute.String("assignment_id", assignmentID)))
+	defer span.End()
+
+	filter := bson.M{"assignment_id": assignmentID, "user_id": userID, "count": bson.M{"$lt": int64(max)}}
+	update := bson.M{"$inc": bson.M{"count": 1}}
+	res, err := r.counts.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.log.Warn(err)
+		return false, dbError(err)
+	}
+	if res.MatchedCount == 1 {
+		return true, nil
+	}
+	// no counter yet, or the limit is reached: create it from the attempts
+	// already made, the unique index letting only one of racing starts do so
+	_, err = r.counts.InsertOne(ctx, AssignmentAttempts{AssignmentID: assignmentID, UserID: userID, Count: used})
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		r.log.Warn(err)
+		return false, dbError(err)
+	}
+	res, err = r.counts.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.log.Warn(err)
+		return false, dbError(err)
+	}
+	return res.MatchedCount == 1, nil
+}
+
+func (r *AssignmentsRepo) Update(ctx context.Context, a *biz.Assignment) (*biz.Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AssignmentsRepo.Update", trace.WithAttributes(attribute.String("id", a.ID)))
+	defer span.End()
+
+	idObj, err := parseID(a.ID)
+	if err != nil {
+		return nil, err
+	}
+	update := bson.M{"$set": bson.M{
+		"opens_at":     a.OpensAt,
+		"closes_at":    a.ClosesAt,
+		"due_at":       a.DueAt,
+		"max_attempts": a.MaxAttempts,
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	return r.decode(r.coll.FindOneAndUpdate(ctx, bson.M{"_id": idObj}, update, opts))
+}
+
+func (r *AssignmentsRepo) Delete(ctx context.Context, id string) error {
+	ctx, span := r.tracer.Start(ctx, "data.AssignmentsRepo.Delete", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	res, err := r.coll.DeleteOne(ctx, bson.M{"_id": idObj})
+	if err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	if res.DeletedCount == 0 {
+		return pb.ErrorAssignmentNotFound("assignment not found")
+	}
+	if _, err := r.counts.DeleteMany(ctx, bson.M{"assignment_id": id}); err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	return nil
+}
+
+func (r *AssignmentsRepo) DeleteByGroup(ctx context.Context, groupID string) error {
+	ctx, span := r.tracer.Start(ctx, "data.AssignmentsRepo.DeleteByGroup", trace.WithAttributes(attribute.String("group_id", groupID)))
+	defer span.End()
+
+	var ids []bson.ObjectID
+	if err := r.coll.Distinct(ctx, "_id", bson.M{"group_id": groupID}).Decode(&ids); err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	if _, err := r.coll.DeleteMany(ctx, bson.M{"group_id": groupID}); err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	hexIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		hexIDs = append(hexIDs, id.Hex())
+	}
+	if _, err := r.counts.DeleteMany(ctx, bson.M{"assignment_id": bson.M{"$in": hexIDs}}); err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	return nil
+}
+
+func (r *AssignmentsRepo) find(ctx context.Context, filter bson.M, opts *options.FindOptionsBuilder) ([]*biz.Assignment, error) {
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var docs []Assignment
+	if err := cur.All(ctx, &docs); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.Assignment, 0, len(docs))
+	for _, d := range docs {
+		res = append(res, d.Biz())
+	}
+	return res, nil
+}
+
+func (r *AssignmentsRepo) decode(res *mongo.SingleResult) (*biz.Assignment, error) {
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorAssignmentNotFound("assignment not found"))
+	}
+	var a Assignment
+	if err := res.Decode(&a); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	return a.Biz(), nil
+}
@@ -0,0 +1,246 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type AttemptAnswer struct {
+	AnswerID string `bson:"answer_id"`
+	Checked  bool   `bson:"checked"`
+}
+
+type AttemptResponse struct {
+	QuestionID  string          `bson:"question_id"`
+	Answers     []AttemptAnswer `bson:"answers"`
+	Score       float32         `bson:"score"`
+	Correct     bool            `bson:"correct"`
+	TimeSpentMs int64           `bson:"time_spent_ms,omitempty"`
+}
+
+type Attempt struct {
+	ID           bson.ObjectID       `bson:"_id,omitempty"`
+	QuizID       string              `bson:"quiz_id"`
+	UserID       string              `bson:"user_id"`
+	AssignmentID string              `bson:"assignment_id,omitempty"`
+	Seed         int64               `bson:"seed"`
+	QuestionIDs  []string            `bson:"question_ids"`
+	AnswerOrders map[string][]string `bson:"answer_orders,omitempty"`
+	Responses    []AttemptResponse   `bson:"responses"`
+	Score        *float32            `bson:"score"`
+	StartedAt    time.Time           `bson:"started_at"`
+	SubmittedAt  *time.Time          `bson:"submitted_at"`
+	Adaptive     bool                `bson:"adaptive,omitempty"`
+	Pool         []string            `bson:"pool,omitempty"`
+	Length       uint32              `bson:"length,omitempty"`
+	Level        int                 `bson:"level,omitempty"`
+	Ability      *float32            `bson:"ability,omitempty"`
+	TokenHash    string              `bson:"token_hash,omitempty"`
+}
+
+// AssignmentProgress is the output of the assignment progress pipeline, one per user.
+type AssignmentProgress struct {
+	UserID      string     `bson:"_id"`
+	Attempts    uint32     `bson:"attempts"`
+	BestScore   *float32   `bson:"best_score"`
+	CompletedAt *time.Time `bson:"completed_at"`
+}
+
+type AttemptsRepo struct {
+	coll   *mongo.Collection
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewAttemptsRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.AttemptsRepo {
+	r := &AttemptsRepo{
+		coll:   data.mongo.Collection("attempts"),
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+	r.ensureIndexes()
+	return r
+}
+
+func (r *AttemptsRepo) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "assignment_id", Value: 1}, {Key: "user_id", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	})
+	if err != nil {
+		r.log.Warnf("failed to create attempts indexes: %v", err)
+	}
+}
+
+func (r *AttemptsRepo) Save(ctx context.Context, a *biz.Attempt) (*biz.Attempt, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AttemptsRepo.Save")
+	defer span.End()
+
+	attempt := AttemptToData(a)
+	res, err := r.coll.InsertOne(ctx, attempt)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	oid, err := insertedID(res)
+	if err != nil {
+		return nil, err
+	}
+	attempt.ID = oid
+	return attempt.Biz(), nil
+}
+
+func (r *AttemptsRepo) GetByID(ctx context.Context, id string) (*biz.Attempt, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AttemptsRepo.GetByID", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.decode(r.coll.FindOne(ctx, bson.M{"_id": idObj}))
+}
+
+func (r *AttemptsRepo) Submit(ctx context.Context, a *biz.Attempt) (*biz.Attempt, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AttemptsRepo.Submit", trace.WithAttributes(attribute.String("id", a.ID)))
+	defer span.End()
+
+	idObj, err := parseID(a.ID)
+	if err != nil {
+		return nil, err
+	}
+	attempt := AttemptToData(a)
+	update := bson.M{"$set": bson.M{
+		"responses":    attempt.Responses,
+		"score":        attempt.Score,
+		"submitted_at": attempt.SubmittedAt,
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	res := r.coll.FindOneAndUpdate(ctx, bson.M{"_id": idObj, "submitted_at": nil}, update, opts)
+	if err := res.Err(); err != nil {
+		// the filter only misses a known attempt once it has been submitted
+		return nil, findError(err, pb.ErrorAttemptAlreadySubmitted("attempt already submitted"))
+	}
+	return r.decode(res)
+}
+
+func (r *AttemptsRepo) Advance(ctx context.Context, a *biz.Attempt, answered int) (*biz.Attempt, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AttemptsRepo.Advance", trace.WithAttributes(attribute.String("id", a.ID)))
+	defer span.End()
+
+	idObj, err := parseID(a.ID)
+	if err != nil {
+		return nil, err
+	}
+	attempt := AttemptToData(a)
+	filter := bson.M{
+		"_id":          idObj,
+		"submitted_at": nil,
+		"responses":    bson.M{"$size": answered},
+	}
+	update := bson.M{"$set": bson.M{
+		"question_ids": attempt.QuestionIDs,
+		"responses":    attempt.Responses,
+		"level":        attempt.Level,
+		"score":        attempt.Score,
+		"ability":      attempt.Ability,
+		"submitted_at": attempt.SubmittedAt,
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	res := r.coll.FindOneAndUpdate(ctx, filter, update, opts)
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorConflict("the question was already answered"))
+	}
+	return r.decode(res)
+}
+
+func (r *AttemptsRepo) decode(res *mongo.SingleResult) (*biz.Attempt, error) {
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorAttemptNotFound("attempt not found"))
+	}
+	var a Attempt
+	if err := res.Decode(&a); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	return a.Biz(), nil
+}
+
+func (r *AttemptsRepo) ForEachSubmitted(ctx context.Context, quizID string, fn func(*biz.Attempt) error) error {
+	ctx, span := r.tracer.Start(ctx, "data.AttemptsRepo.ForEachSubmitted", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	cur, err := r.coll.Find(ctx, bson.M{"quiz_id": quizID, "submitted_at": bson.M{"$ne": nil}})
+	if err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var a Attempt
+		if err := cur.Decode(&a); err != nil {
+			r.log.Warn(err)
+			return dbError(err)
+		}
+		if err := fn(a.Biz()); err != nil {
+			return dbError(err)
+		}
+	}
+	return cur.Err()
+}
+
+func (r *AttemptsRepo) CountForAssignment(ctx context.Context, assignmentID string, userID string) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AttemptsRepo.CountForAssignment", trace.WithAttributes(attribute.String("assignment_id", assignmentID)))
+	defer span.End()
+
+	n, err := r.coll.CountDocuments(ctx, bson.M{"assignment_id": assignmentID, "user_id": userID})
+	if err != nil {
+		r.log.Warn(err)
+		return 0, dbError(err)
+	}
+	return n, nil
+}
+
+func (r *AttemptsRepo) AssignmentProgress(ctx context.Context, assignmentID string) ([]*biz.AssignmentProgress, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AttemptsRepo.AssignmentProgress", trace.WithAttributes(attribute.String("assignment_id", assignmentID)))
+	defer span.End()
+
+	// $max and $min skip the nulls of attempts still in progress
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"assignment_id": assignmentID}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":          "$user_id",
+			"attempts":     bson.M{"$sum": 1},
+			"best_score":   bson.M{"$max": "$score"},
+			"completed_at": bson.M{"$min": "$submitted_at"},
+		}}},
+	}
+	cur, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var progress []AssignmentProgress
+	if err := cur.All(ctx, &progress); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.AssignmentProgress, 0, len(progress))
+	for _, p := range progress {
+		res = append(res, p.Biz())
+	}
+	return res, nil
+}
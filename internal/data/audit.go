@@ -0,0 +1,107 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+)
+
+type AuditEntry struct {
+	ID         bson.ObjectID `bson:"_id,omitempty"`
+	ActorID    string        `bson:"actor_id"`
+	OwnerID    string        `bson:"owner_id"`
+	Action     string        `bson:"action"`
+	EntityType string        `bson:"entity_type"`
+	EntityID   string        `bson:"entity_id"`
+	At         time.Time     `bson:"at"`
+	TraceID    string        `bson:"trace_id,omitempty"`
+	Diff       string        `bson:"diff"`
+}
+
+// AuditRepo appends to the "audit_log" collection; nothing here updates or
+// deletes an entry.
+type AuditRepo struct {
+	coll   *mongo.Collection
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewAuditRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.AuditRepo {
+	r := &AuditRepo{
+		coll:   data.mongo.Collection("audit_log"),
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+	r.ensureIndexes()
+	return r
+}
+
+func (r *AuditRepo) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "entity_type", Value: 1}, {Key: "entity_id", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "actor_id", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "owner_id", Value: 1}, {Key: "_id", Value: -1}}},
+	})
+	if err != nil {
+		r.log.Warnf("failed to create audit indexes: %v", err)
+	}
+}
+
+func (r *AuditRepo) Add(ctx context.Context, e *biz.AuditEntry) error {
+	ctx, span := r.tracer.Start(ctx, "data.AuditRepo.Add", trace.WithAttributes(attribute.String("entity_id", e.EntityID)))
+	defer span.End()
+
+	if _, err := r.coll.InsertOne(ctx, AuditEntryToData(e)); err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	return nil
+}
+
+func (r *AuditRepo) List(ctx context.Context, f *biz.AuditFilter, pagination *biz.Pagination) ([]*biz.AuditEntry, error) {
+	ctx, span := r.tracer.Start(ctx, "data.AuditRepo.List")
+	defer span.End()
+
+	filter := bson.M{}
+	if f.EntityType != "" {
+		filter["entity_type"] = f.EntityType
+	}
+	if f.EntityID != "" {
+		filter["entity_id"] = f.EntityID
+	}
+	if f.ActorID != "" {
+		filter["actor_id"] = f.ActorID
+	}
+	if f.VisibleTo != "" {
+		filter["$or"] = bson.A{bson.M{"actor_id": f.VisibleTo}, bson.M{"owner_id": f.VisibleTo}}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetSkip(int64(pagination.Page * pagination.Size)).
+		SetLimit(int64(pagination.Size))
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var entries []AuditEntry
+	if err := cur.All(ctx, &entries); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.AuditEntry, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, e.Biz())
+	}
+	return res, nil
+}
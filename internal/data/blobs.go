@@ -0,0 +1,137 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/minio/minio-go/v7"
+	"quiz/internal/biz"
+	"quiz/internal/conf"
+	"quiz/internal/dep"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	BlobStoreLocal = "local"
+	BlobStoreS3    = "s3"
+
+	defaultMediaDir = "media"
+)
+
+// NewBlobStore returns the store configured under media.store.
+func NewBlobStore(bc *conf.Bootstrap, logger log.Logger) (biz.BlobStore, error) {
+	c := bc.GetMedia()
+	switch c.GetStore() {
+	case BlobStoreS3:
+		client, err := dep.NewMinio(c.GetS3(), logger)
+		if err != nil {
+			return nil, err
+		}
+		return &s3Store{client: client, bucket: c.GetS3().GetBucket()}, nil
+	default:
+		dir := c.GetLocal().GetDir()
+		if dir == "" {
+			dir = defaultMediaDir
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		return &localStore{dir: dir}, nil
+	}
+}
+
+// localStore keeps one file per key in dir.
+type localStore struct {
+	dir string
+}
+
+func (s *localStore) Put(_ context.Context, key string, r io.Reader, _ int64, _ string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	// write next to the target and rename, so a failed upload never leaves a
+	// partial file under key
+	f, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), name)
+}
+
+func (s *localStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, pb.ErrorMediaNotFound("media content not found")
+	}
+	return f, err
+}
+
+func (s *localStore) Delete(_ context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *localStore) path(key string) (string, error) {
+	if key == "" || strings.ContainsAny(key, `/\`) || strings.HasPrefix(key, ".") {
+		return "", pb.ErrorInvalidArgument("invalid blob key %q", key)
+	}
+	return filepath.Join(s.dir, key), nil
+}
+
+// s3Store keeps one object per key in a bucket of an S3-compatible store.
+type s3Store struct {
+	client *minio.Client
+	bucket string
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject is lazy; stat to report a missing object before any content
+	// is written out
+	if _, err := obj.Stat(); err != nil {
+		_ = obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, pb.ErrorMediaNotFound("media content not found")
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
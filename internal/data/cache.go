@@ -0,0 +1,266 @@
+package data
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+)
+
+// cacheTTL bounds how long an entry can outlive a write whose invalidation
+// raced with a concurrent read-through.
+const cacheTTL = 5 * time.Minute
+
+// cache stores documents in their database encoding so fields biz keeps
+// private, like the answer key, survive the round trip.
+type cache struct {
+	rdb    *redis.Client
+	name   attribute.KeyValue
+	hits   metric.Int64Counter
+	misses metric.Int64Counter
+	log    *log.Helper
+}
+
+func newCache(rdb *redis.Client, name string, meter metric.Meter, logger log.Logger) (*cache, error) {
+	hits, err := meter.Int64Counter("cache.hits", metric.WithDescription("Reads served from the Redis cache"))
+	if err != nil {
+		return nil, err
+	}
+	misses, err := meter.Int64Counter("cache.misses", metric.WithDescription("Reads that fell through to the database"))
+	if err != nil {
+		return nil, err
+	}
+	return &cache{
+		rdb:    rdb,
+		name:   attribute.String("cache", name),
+		hits:   hits,
+		misses: misses,
+		log:    log.NewHelper(logger),
+	}, nil
+}
+
+// decode reports whether raw held a value, counting the hit or miss. Redis
+// errors are logged and treated as a miss so the database still answers.
+func (c *cache) decode(ctx context.Context, raw []byte, err error, v any) bool {
+	if err == nil {
+		err = bson.Unmarshal(raw, v)
+	}
+	if err != nil {
+		if !stderrors.Is(err, redis.Nil) {
+			c.log.Warn(err)
+		}
+		c.misses.Add(ctx, 1, metric.WithAttributes(c.name))
+		return false
+	}
+	c.hits.Add(ctx, 1, metric.WithAttributes(c.name))
+	return true
+}
+
+func (c *cache) set(ctx context.Context, key string, v any) {
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		c.log.Warn(err)
+		return
+	}
+	if err := c.rdb.Set(ctx, key, raw, cacheTTL).Err(); err != nil {
+		c.log.Warn(err)
+	}
+}
+
+func (c *cache) hset(ctx context.Context, key string, field string, v any) {
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		c.log.Warn(err)
+		return
+	}
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, key, field, raw)
+	pipe.Expire(ctx, key, cacheTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		c.log.Warn(err)
+	}
+}
+
+// invalidate drops keys once the write made in ctx is committed, so that a
+// read between the write and the commit cannot cache the old value again and
+// an aborted transaction leaves the cache alone.
+func (c *cache) invalidate(ctx context.Context, keys ...string) {
+	ctx = context.WithoutCancel(ctx)
+	afterCommit(ctx, func() {
+		if err := c.rdb.Del(ctx, keys...).Err(); err != nil {
+			c.log.Warn(err)
+		}
+	})
+}
+
+// CachedQuizRepo reads quizzes through Redis by ID.
+type CachedQuizRepo struct {
+	biz.QuizRepo
+	cache  *cache
+	tracer trace.Tracer
+}
+
+// NewCachedQuizRepo wraps repo in a read-through cache, or returns it as is
+// when Redis is not configured.
+func NewCachedQuizRepo(data *Data, repo *QuizRepo, meter metric.Meter, logger log.Logger, tracer trace.Tracer) (biz.QuizRepo, error) {
+	if data.redis == nil {
+		return repo, nil
+	}
+	c, err := newCache(data.redis, "quiz", meter, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &CachedQuizRepo{QuizRepo: repo, cache: c, tracer: tracer}, nil
+}
+
+func quizCacheKey(id string) string {
+	return "quiz:" + id
+}
+
+func (r *CachedQuizRepo) GetByID(ctx context.Context, id string) (*biz.Quiz, error) {
+	ctx, span := r.tracer.Start(ctx, "data.CachedQuizRepo.GetByID", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	// a transaction reads what it is about to change, which the cache may
+	// hold stale until the writes of another transaction commit
+	if inTx(ctx) {
+		return r.QuizRepo.GetByID(ctx, id)
+	}
+	key := quizCacheKey(id)
+	raw, err := r.cache.rdb.Get(ctx, key).Bytes()
+	var cached Quiz
+	if r.cache.decode(ctx, raw, err, &cached) {
+		span.SetAttributes(attribute.Bool("cache_hit", true))
+		return cached.QuizToBiz(), nil
+	}
+	q, err := r.QuizRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if doc, err := QuizToData(q); err == nil {
+		r.cache.set(ctx, key, doc)
+	}
+	return q, nil
+}
+
+func (r *CachedQuizRepo) Update(ctx context.Context, q *biz.Quiz) (*biz.Quiz, error) {
+	res, err := r.QuizRepo.Update(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.invalidate(ctx, quizCacheKey(q.ID))
+	return res, nil
+}
+
+func (r *CachedQuizRepo) Publish(ctx context.Context, id string, at string) (*biz.Quiz, error) {
+	res, err := r.QuizRepo.Publish(ctx, id, at)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.invalidate(ctx, quizCacheKey(id))
+	return res, nil
+}
+
+func (r *CachedQuizRepo) Delete(ctx context.Context, id string) (*biz.Quiz, error) {
+	res, err := r.QuizRepo.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.invalidate(ctx, quizCacheKey(id))
+	return res, nil
+}
+
+// CachedQuestionsRepo reads the question pages of a quiz through Redis. The
+// pages of a quiz share one hash so a write drops them all at once.
+type CachedQuestionsRepo struct {
+	biz.QuestionsRepo
+	cache  *cache
+	tracer trace.Tracer
+}
+
+type cachedQuestions struct {
+	Questions []*Question `bson:"questions"`
+}
+
+// NewCachedQuestionsRepo wraps repo in a read-through cache, or returns it as
+// is when Redis is not configured.
+func NewCachedQuestionsRepo(data *Data, repo *QuestionsRepo, meter metric.Meter, logger log.Logger, tracer trace.Tracer) (biz.QuestionsRepo, error) {
+	if data.redis == nil {
+		return repo, nil
+	}
+	c, err := newCache(data.redis, "questions", meter, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &CachedQuestionsRepo{QuestionsRepo: repo, cache: c, tracer: tracer}, nil
+}
+
+func questionsCacheKey(quizID string) string {
+	return "questions:" + quizID
+}
+
+func (r *CachedQuestionsRepo) List(ctx context.Context, quizID string, pagination *biz.Pagination) ([]*biz.Question, error) {
+	ctx, span := r.tracer.Start(ctx, "data.CachedQuestionsRepo.List", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	if inTx(ctx) {
+		return r.QuestionsRepo.List(ctx, quizID, pagination)
+	}
+	key := questionsCacheKey(quizID)
+	field := fmt.Sprintf("%d:%d", pagination.Page, pagination.Size)
+	raw, err := r.cache.rdb.HGet(ctx, key, field).Bytes()
+	var cached cachedQuestions
+	if r.cache.decode(ctx, raw, err, &cached) {
+		span.SetAttributes(attribute.Bool("cache_hit", true))
+		res := make([]*biz.Question, 0, len(cached.Questions))
+		for _, q := range cached.Questions {
+			res = append(res, q.Biz())
+		}
+		return res, nil
+	}
+	res, err := r.QuestionsRepo.List(ctx, quizID, pagination)
+	if err != nil {
+		return nil, err
+	}
+	cached.Questions = make([]*Question, 0, len(res))
+	for _, q := range res {
+		cached.Questions = append(cached.Questions, QuestionToData(q))
+	}
+	r.cache.hset(ctx, key, field, &cached)
+	return res, nil
+}
+
+func (r *CachedQuestionsRepo) Save(ctx context.Context, q *biz.Question) (*biz.Question, error) {
+	res, err := r.QuestionsRepo.Save(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.invalidate(ctx, questionsCacheKey(res.QuizID))
+	return res, nil
+}
+
+func (r *CachedQuestionsRepo) Update(ctx context.Context, q *biz.Question) (*biz.Question, error) {
+	res, err := r.QuestionsRepo.Update(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.invalidate(ctx, questionsCacheKey(res.QuizID))
+	return res, nil
+}
+
+func (r *CachedQuestionsRepo) Delete(ctx context.Context, id string) (*biz.Question, error) {
+	res, err := r.QuestionsRepo.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.invalidate(ctx, questionsCacheKey(res.QuizID))
+	return res, nil
+}
@@ -0,0 +1,148 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type Collaborator struct {
+	ID         bson.ObjectID `bson:"_id,omitempty"`
+	QuizID     string        `bson:"quiz_id"`
+	UserID     string        `bson:"user_id"`
+	Role       int32         `bson:"role"`
+	InvitedBy  string        `bson:"invited_by"`
+	InvitedAt  time.Time     `bson:"invited_at"`
+	AcceptedAt *time.Time    `bson:"accepted_at"`
+}
+
+// CollaboratorsRepo keeps one document per quiz and invited user in the
+// "quiz_collaborators" collection.
+type CollaboratorsRepo struct {
+	coll   *mongo.Collection
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewCollaboratorsRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.CollaboratorsRepo {
+	r := &CollaboratorsRepo{
+		coll:   data.mongo.Collection("quiz_collaborators"),
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+	r.ensureIndexes()
+	return r
+}
+
+func (r *CollaboratorsRepo) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "quiz_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Warnf("failed to create collaborator indexes: %v", err)
+	}
+}
+
+func (r *CollaboratorsRepo) Invite(ctx context.Context, c *biz.Collaborator) (*biz.Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "data.CollaboratorsRepo.Invite", trace.WithAttributes(attribute.String("quiz_id", c.QuizID)))
+	defer span.End()
+
+	doc := CollaboratorToData(c)
+	update := bson.M{
+		"$set": bson.M{"role": doc.Role, "invited_by": doc.InvitedBy},
+		"$setOnInsert": bson.M{
+			"invited_at":  doc.InvitedAt,
+			"accepted_at": nil,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	res := r.coll.FindOneAndUpdate(ctx, bson.M{"quiz_id": c.QuizID, "user_id": c.UserID}, update, opts)
+	return r.decode(res)
+}
+
+func (r *CollaboratorsRepo) Get(ctx context.Context, quizID string, userID string) (*biz.Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "data.CollaboratorsRepo.Get", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	return r.decode(r.coll.FindOne(ctx, bson.M{"quiz_id": quizID, "user_id": userID}))
+}
+
+func (r *CollaboratorsRepo) Accept(ctx context.Context, quizID string, userID string, at time.Time) (*biz.Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "data.CollaboratorsRepo.Accept", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	res := r.coll.FindOneAndUpdate(ctx, bson.M{"quiz_id": quizID, "user_id": userID}, bson.M{"$set": bson.M{"accepted_at": at}}, opts)
+	return r.decode(res)
+}
+
+func (r *CollaboratorsRepo) List(ctx context.Context, quizID string, pagination *biz.Pagination) ([]*biz.Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "data.CollaboratorsRepo.List", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "invited_at", Value: 1}}).
+		SetSkip(int64(pagination.Page * pagination.Size)).
+		SetLimit(int64(pagination.Size))
+	cur, err := r.coll.Find(ctx, bson.M{"quiz_id": quizID}, opts)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var docs []Collaborator
+	if err := cur.All(ctx, &docs); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.Collaborator, 0, len(docs))
+	for _, d := range docs {
+		res = append(res, d.Biz())
+	}
+	return res, nil
+}
+
+func (r *CollaboratorsRepo) Remove(ctx context.Context, quizID string, userID string) (*biz.Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "data.CollaboratorsRepo.Remove", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	return r.decode(r.coll.FindOneAndDelete(ctx, bson.M{"quiz_id": quizID, "user_id": userID}))
+}
+
+func (r *CollaboratorsRepo) RemoveAll(ctx context.Context, quizID string) error {
+	ctx, span := r.tracer.Start(ctx, "data.CollaboratorsRepo.RemoveAll", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	if _, err := r.coll.DeleteMany(ctx, bson.M{"quiz_id": quizID}); err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	return nil
+}
+
+func (r *CollaboratorsRepo) decode(res *mongo.SingleResult) (*biz.Collaborator, error) {
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorCollaboratorNotFound("collaborator not found"))
+	}
+	var doc Collaborator
+	if err := res.Decode(&doc); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	return doc.Biz(), nil
+}
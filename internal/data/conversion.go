@@ -1,7 +1,9 @@
 package data
 
 import (
-	"github.com/go-kratos/kratos/v2/errors"
+	"encoding/json"
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"quiz/internal/biz"
 )
@@ -39,6 +41,25 @@ func (q *Quiz) QuizToBiz() *biz.Quiz {
 	if q.Metadata != nil {
 		bizQuiz.Metadata = q.Metadata
 	}
+	if q.Premium != nil {
+		bizQuiz.Premium = q.Premium
+	}
+	if q.QuestionIDs != nil {
+		bizQuiz.QuestionIDs = q.QuestionIDs
+	}
+	if q.Adaptive != nil {
+		bizQuiz.Adaptive = &biz.AdaptiveSettings{Length: q.Adaptive.Length, StartDifficulty: q.Adaptive.StartDifficulty}
+	}
+	if q.Shuffle != nil {
+		bizQuiz.Shuffle = &biz.ShuffleSettings{Questions: q.Shuffle.Questions, Answers: q.Shuffle.Answers}
+	}
+	if q.DrawRules != nil {
+		rules := make([]biz.DrawRule, 0, len(q.DrawRules))
+		for _, r := range q.DrawRules {
+			rules = append(rules, biz.DrawRule{Count: r.Count, Difficulty: r.Difficulty, Tags: r.Tags})
+		}
+		bizQuiz.DrawRules = rules
+	}
 	if q.CreatedBy != "" {
 		bizQuiz.CreatedBy = q.CreatedBy
 	}
@@ -48,6 +69,11 @@ func (q *Quiz) QuizToBiz() *biz.Quiz {
 	if q.DeletedBy != "" {
 		bizQuiz.DeletedBy = q.DeletedBy
 	}
+	bizQuiz.PublishedAt = q.PublishedAt
+	bizQuiz.DefaultLocale = q.DefaultLocale
+	bizQuiz.Locales = q.Locales
+	bizQuiz.TitleTranslations = q.TitleTranslations
+	bizQuiz.DescriptionTranslations = q.DescriptionTranslations
 	if q.CreatedAt != "" {
 		bizQuiz.CreatedAt = q.CreatedAt
 	}
@@ -63,9 +89,9 @@ func (q *Quiz) QuizToBiz() *biz.Quiz {
 func QuizToData(q *biz.Quiz) (*Quiz, error) {
 	var dataQuiz Quiz
 	if q.ID != "" {
-		oid, err := bson.ObjectIDFromHex(q.ID)
+		oid, err := parseID(q.ID)
 		if err != nil {
-			return nil, errors.InternalServer("biz.Quiz.ID was defined as an invalid ObjectID", err.Error())
+			return nil, err
 		}
 		dataQuiz.ID = oid
 	}
@@ -96,6 +122,17 @@ func QuizToData(q *biz.Quiz) (*Quiz, error) {
 	if q.Metadata != nil {
 		dataQuiz.Metadata = q.Metadata
 	}
+	if q.Premium != nil {
+		dataQuiz.Premium = q.Premium
+	}
+	if q.QuestionIDs != nil {
+		dataQuiz.QuestionIDs = q.QuestionIDs
+	}
+	if q.DrawRules != nil {
+		dataQuiz.DrawRules = DrawRulesToData(q.DrawRules)
+	}
+	dataQuiz.Shuffle = ShuffleToData(q.Shuffle)
+	dataQuiz.Adaptive = AdaptiveToData(q.Adaptive)
 	if q.CreatedBy != "" {
 		dataQuiz.CreatedBy = q.CreatedBy
 	}
@@ -105,6 +142,11 @@ func QuizToData(q *biz.Quiz) (*Quiz, error) {
 	if q.DeletedBy != "" {
 		dataQuiz.DeletedBy = q.DeletedBy
 	}
+	dataQuiz.PublishedAt = q.PublishedAt
+	dataQuiz.DefaultLocale = q.DefaultLocale
+	dataQuiz.Locales = q.Locales
+	dataQuiz.TitleTranslations = q.TitleTranslations
+	dataQuiz.DescriptionTranslations = q.DescriptionTranslations
 	if q.CreatedAt != "" {
 		dataQuiz.CreatedAt = q.CreatedAt
 	}
@@ -117,6 +159,31 @@ func QuizToData(q *biz.Quiz) (*Quiz, error) {
 	return &dataQuiz, nil
 }
 
+func DrawRulesToData(rules []biz.DrawRule) []DrawRule {
+	if rules == nil {
+		return nil
+	}
+	res := make([]DrawRule, 0, len(rules))
+	for _, r := range rules {
+		res = append(res, DrawRule{Count: r.Count, Difficulty: r.Difficulty, Tags: r.Tags})
+	}
+	return res
+}
+
+func ShuffleToData(s *biz.ShuffleSettings) *ShuffleSettings {
+	if s == nil {
+		return nil
+	}
+	return &ShuffleSettings{Questions: s.Questions, Answers: s.Answers}
+}
+
+func AdaptiveToData(a *biz.AdaptiveSettings) *AdaptiveSettings {
+	if a == nil {
+		return nil
+	}
+	return &AdaptiveSettings{Length: a.Length, StartDifficulty: a.StartDifficulty}
+}
+
 func (q *Question) Biz() *biz.Question {
 	var bizQuestion biz.Question
 
@@ -139,12 +206,20 @@ func (q *Question) Biz() *biz.Question {
 		var answers []biz.Answer
 		for _, a := range q.Answers {
 			answer := &biz.Answer{
-				Text: a.Text,
+				ID:               a.ID,
+				Text:             a.Text,
+				TextTranslations: a.TextTranslations,
+				Pinned:           a.Pinned,
+				Media:            AttachmentsFromData(a.Media),
+
+				Format:            biz.ContentFormat(a.Format),
+				ExplanationFormat: biz.ContentFormat(a.ExplanationFormat),
 			}
 			answer.SetIsCorrect(a.IsCorrect)
 			if a.Explanation != "" {
 				answer.SetExplanation(a.Explanation)
 			}
+			answer.SetExplanationTranslations(a.ExplanationTranslations)
 			answers = append(answers, *answer)
 		}
 		bizQuestion.Answers = answers
@@ -152,6 +227,13 @@ func (q *Question) Biz() *biz.Question {
 	if q.Order != 0 {
 		bizQuestion.Order = q.Order
 	}
+	if q.Tags != nil {
+		bizQuestion.Tags = q.Tags
+	}
+	bizQuestion.Media = AttachmentsFromData(q.Media)
+	bizQuestion.Format = biz.ContentFormat(q.Format)
+	bizQuestion.QuestionTranslations = q.QuestionTranslations
+	bizQuestion.HintTranslations = q.HintTranslations
 	if q.CreatedBy != "" {
 		bizQuestion.CreatedBy = q.CreatedBy
 	}
@@ -172,3 +254,418 @@ func (q *Question) Biz() *biz.Question {
 	}
 	return &bizQuestion
 }
+
+func QuestionToData(q *biz.Question) *Question {
+	difficulty := q.Difficulty
+	question := &Question{
+		QuizID:     q.QuizID,
+		Question:   q.Question,
+		Difficulty: &difficulty,
+		Order:      q.Order,
+		Hint:       q.Hint,
+		Tags:       q.Tags,
+		Media:      AttachmentsToData(q.Media),
+		Format:     int32(q.Format),
+
+		QuestionTranslations: q.QuestionTranslations,
+		HintTranslations:     q.HintTranslations,
+
+		CreatedBy: q.CreatedBy,
+		UpdatedBy: q.UpdatedBy,
+		DeletedBy: q.DeletedBy,
+		CreatedAt: q.CreatedAt,
+		UpdatedAt: q.UpdatedAt,
+		DeletedAt: q.DeletedAt,
+	}
+	if q.ID != "" {
+		if oid, err := bson.ObjectIDFromHex(q.ID); err == nil {
+			question.ID = oid
+		}
+	}
+	answers := make([]Answer, 0, len(q.Answers))
+	for _, a := range q.Answers {
+		answers = append(answers, Answer{
+			ID:                      a.ID,
+			Text:                    a.Text,
+			TextTranslations:        a.TextTranslations,
+			IsCorrect:               a.IsCorrect(),
+			Explanation:             a.Explanation(),
+			ExplanationTranslations: a.ExplanationTranslations(),
+			Pinned:                  a.Pinned,
+			Media:                   AttachmentsToData(a.Media),
+			Format:                  int32(a.Format),
+			ExplanationFormat:       int32(a.ExplanationFormat),
+		})
+	}
+	question.Answers = answers
+	return question
+}
+
+func (p *Products) Biz() *biz.Product {
+	return &biz.Product{
+		ID:          p.ID.Hex(),
+		Name:        p.Name,
+		Description: p.Description,
+		Price:       p.Price,
+		Category:    p.Category,
+		Tags:        p.Tags,
+		Attributes:  p.Attributes,
+		Thumbnail:   &p.Thumbnail,
+		Images:      p.Images,
+		QuizIDs:     p.QuizIDs,
+	}
+}
+
+func (e *Entitlement) Biz() *biz.Entitlement {
+	return &biz.Entitlement{
+		ID:        e.ID.Hex(),
+		UserID:    e.UserID,
+		ProductID: e.ProductID,
+		Source:    e.Source,
+		Reference: e.Reference,
+		GrantedBy: e.GrantedBy,
+		GrantedAt: e.GrantedAt,
+		ExpiresAt: e.ExpiresAt,
+		RevokedAt: e.RevokedAt,
+	}
+}
+
+func EntitlementToData(e *biz.Entitlement) *Entitlement {
+	return &Entitlement{
+		UserID:    e.UserID,
+		ProductID: e.ProductID,
+		Source:    e.Source,
+		Reference: e.Reference,
+		GrantedBy: e.GrantedBy,
+		GrantedAt: e.GrantedAt,
+		ExpiresAt: e.ExpiresAt,
+		RevokedAt: e.RevokedAt,
+	}
+}
+
+func (a *Attempt) Biz() *biz.Attempt {
+	responses := make([]biz.AttemptResponse, 0, len(a.Responses))
+	for _, r := range a.Responses {
+		answers := make([]biz.AttemptAnswer, 0, len(r.Answers))
+		for _, ans := range r.Answers {
+			answers = append(answers, biz.AttemptAnswer{AnswerID: ans.AnswerID, Checked: ans.Checked})
+		}
+		responses = append(responses, biz.AttemptResponse{
+			QuestionID: r.QuestionID,
+			Answers:    answers,
+			Score:      r.Score,
+			Correct:    r.Correct,
+			TimeSpent:  time.Duration(r.TimeSpentMs) * time.Millisecond,
+		})
+	}
+	return &biz.Attempt{
+		ID:           a.ID.Hex(),
+		QuizID:       a.QuizID,
+		UserID:       a.UserID,
+		AssignmentID: a.AssignmentID,
+		Seed:         a.Seed,
+		QuestionIDs:  a.QuestionIDs,
+		AnswerOrders: a.AnswerOrders,
+		Responses:    responses,
+		Score:        a.Score,
+		StartedAt:    a.StartedAt,
+		SubmittedAt:  a.SubmittedAt,
+		Adaptive:     a.Adaptive,
+		Pool:         a.Pool,
+		Length:       a.Length,
+		Level:        biz.Difficulty(a.Level),
+		Ability:      a.Ability,
+		TokenHash:    a.TokenHash,
+	}
+}
+
+func AttemptToData(a *biz.Attempt) *Attempt {
+	responses := make([]AttemptResponse, 0, len(a.Responses))
+	for _, r := range a.Responses {
+		answers := make([]AttemptAnswer, 0, len(r.Answers))
+		for _, ans := range r.Answers {
+			answers = append(answers, AttemptAnswer{AnswerID: ans.AnswerID, Checked: ans.Checked})
+		}
+		responses = append(responses, AttemptResponse{
+			QuestionID:  r.QuestionID,
+			Answers:     answers,
+			Score:       r.Score,
+			Correct:     r.Correct,
+			TimeSpentMs: r.TimeSpent.Milliseconds(),
+		})
+	}
+	return &Attempt{
+		QuizID:       a.QuizID,
+		UserID:       a.UserID,
+		AssignmentID: a.AssignmentID,
+		Seed:         a.Seed,
+		QuestionIDs:  a.QuestionIDs,
+		AnswerOrders: a.AnswerOrders,
+		Responses:    responses,
+		Score:        a.Score,
+		StartedAt:    a.StartedAt,
+		SubmittedAt:  a.SubmittedAt,
+		Adaptive:     a.Adaptive,
+		Pool:         a.Pool,
+		Length:       a.Length,
+		Level:        int(a.Level),
+		Ability:      a.Ability,
+		TokenHash:    a.TokenHash,
+	}
+}
+
+func (s *QuizStats) Biz() *biz.QuizStats {
+	return &biz.QuizStats{
+		QuizID:        s.QuizID,
+		UserID:        s.UserID,
+		Attempts:      s.Attempts,
+		BestScore:     s.BestScore,
+		LatestScore:   s.LatestScore,
+		AverageScore:  s.AverageScore,
+		TimeSpent:     time.Duration(s.TimeSpentMs) * time.Millisecond,
+		LastAttemptAt: s.LastAttemptAt,
+	}
+}
+
+func (e *OutboxEvent) Biz() *biz.DomainEvent {
+	return &biz.DomainEvent{
+		ID:          e.EventID,
+		Type:        e.Type,
+		AggregateID: e.AggregateID,
+		OccurredAt:  e.OccurredAt,
+		Data:        json.RawMessage(e.Data),
+	}
+}
+
+func OutboxEventToData(e *biz.DomainEvent) *OutboxEvent {
+	return &OutboxEvent{
+		EventID:     e.ID,
+		Type:        e.Type,
+		AggregateID: e.AggregateID,
+		OccurredAt:  e.OccurredAt,
+		Data:        string(e.Data),
+	}
+}
+
+func (w *Webhook) Biz() *biz.Webhook {
+	return &biz.Webhook{
+		ID:        w.ID.Hex(),
+		OwnerID:   w.OwnerID,
+		URL:       w.URL,
+		Events:    w.Events,
+		Secret:    w.Secret,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func WebhookToData(w *biz.Webhook) *Webhook {
+	return &Webhook{
+		OwnerID:   w.OwnerID,
+		URL:       w.URL,
+		Events:    w.Events,
+		Secret:    w.Secret,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func (d *WebhookDelivery) Biz() *biz.WebhookDelivery {
+	return &biz.WebhookDelivery{
+		ID:             d.ID.Hex(),
+		WebhookID:      d.WebhookID,
+		OwnerID:        d.OwnerID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        []byte(d.Payload),
+		Status:         biz.WebhookStatus(d.Status),
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
+func WebhookDeliveryToData(d *biz.WebhookDelivery) *WebhookDelivery {
+	return &WebhookDelivery{
+		WebhookID:      d.WebhookID,
+		OwnerID:        d.OwnerID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        string(d.Payload),
+		Status:         int32(d.Status),
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
+func (e *AuditEntry) Biz() *biz.AuditEntry {
+	return &biz.AuditEntry{
+		ID:         e.ID.Hex(),
+		ActorID:    e.ActorID,
+		OwnerID:    e.OwnerID,
+		Action:     e.Action,
+		EntityType: e.EntityType,
+		EntityID:   e.EntityID,
+		At:         e.At,
+		TraceID:    e.TraceID,
+		Diff:       json.RawMessage(e.Diff),
+	}
+}
+
+func AuditEntryToData(e *biz.AuditEntry) *AuditEntry {
+	return &AuditEntry{
+		ActorID:    e.ActorID,
+		OwnerID:    e.OwnerID,
+		Action:     e.Action,
+		EntityType: e.EntityType,
+		EntityID:   e.EntityID,
+		At:         e.At,
+		TraceID:    e.TraceID,
+		Diff:       string(e.Diff),
+	}
+}
+
+func (c *Collaborator) Biz() *biz.Collaborator {
+	return &biz.Collaborator{
+		QuizID:     c.QuizID,
+		UserID:     c.UserID,
+		Role:       biz.CollaboratorRole(c.Role),
+		InvitedBy:  c.InvitedBy,
+		InvitedAt:  c.InvitedAt,
+		AcceptedAt: c.AcceptedAt,
+	}
+}
+
+func CollaboratorToData(c *biz.Collaborator) *Collaborator {
+	return &Collaborator{
+		QuizID:     c.QuizID,
+		UserID:     c.UserID,
+		Role:       int32(c.Role),
+		InvitedBy:  c.InvitedBy,
+		InvitedAt:  c.InvitedAt,
+		AcceptedAt: c.AcceptedAt,
+	}
+}
+
+func (p *AssignmentProgress) Biz() *biz.AssignmentProgress {
+	return &biz.AssignmentProgress{
+		UserID:      p.UserID,
+		Attempts:    p.Attempts,
+		BestScore:   p.BestScore,
+		CompletedAt: p.CompletedAt,
+	}
+}
+
+func (g *Group) Biz() *biz.Group {
+	return &biz.Group{
+		ID:          g.ID.Hex(),
+		OwnerID:     g.OwnerID,
+		Name:        g.Name,
+		Description: g.Description,
+		MemberIDs:   g.MemberIDs,
+		CreatedAt:   g.CreatedAt,
+		UpdatedAt:   g.UpdatedAt,
+	}
+}
+
+func GroupToData(g *biz.Group) *Group {
+	return &Group{
+		OwnerID:     g.OwnerID,
+		Name:        g.Name,
+		Description: g.Description,
+		MemberIDs:   g.MemberIDs,
+		CreatedAt:   g.CreatedAt,
+		UpdatedAt:   g.UpdatedAt,
+	}
+}
+
+func (a *Assignment) Biz() *biz.Assignment {
+	return &biz.Assignment{
+		ID:          a.ID.Hex(),
+		QuizID:      a.QuizID,
+		GroupID:     a.GroupID,
+		OwnerID:     a.OwnerID,
+		OpensAt:     a.OpensAt,
+		ClosesAt:    a.ClosesAt,
+		DueAt:       a.DueAt,
+		MaxAttempts: a.MaxAttempts,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func AssignmentToData(a *biz.Assignment) *Assignment {
+	return &Assignment{
+		QuizID:      a.QuizID,
+		GroupID:     a.GroupID,
+		OwnerID:     a.OwnerID,
+		OpensAt:     a.OpensAt,
+		ClosesAt:    a.ClosesAt,
+		DueAt:       a.DueAt,
+		MaxAttempts: a.MaxAttempts,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func (m *Media) Biz() *biz.Media {
+	return &biz.Media{
+		ID:          m.ID.Hex(),
+		OwnerID:     m.OwnerID,
+		Filename:    m.Filename,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		Checksum:    m.Checksum,
+		Key:         m.Key,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func MediaToData(m *biz.Media) *Media {
+	return &Media{
+		OwnerID:     m.OwnerID,
+		Filename:    m.Filename,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		Checksum:    m.Checksum,
+		Key:         m.Key,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func AttachmentsFromData(atts []Attachment) []biz.Attachment {
+	if len(atts) == 0 {
+		return nil
+	}
+	res := make([]biz.Attachment, 0, len(atts))
+	for _, a := range atts {
+		res = append(res, biz.Attachment{
+			Kind:     biz.MediaKind(a.Kind),
+			MediaID:  a.MediaID,
+			Code:     a.Code,
+			Language: a.Language,
+			Caption:  a.Caption,
+		})
+	}
+	return res
+}
+
+func AttachmentsToData(atts []biz.Attachment) []Attachment {
+	if len(atts) == 0 {
+		return nil
+	}
+	res := make([]Attachment, 0, len(atts))
+	for _, a := range atts {
+		res = append(res, Attachment{
+			Kind:     int32(a.Kind),
+			MediaID:  a.MediaID,
+			Code:     a.Code,
+			Language: a.Language,
+			Caption:  a.Caption,
+		})
+	}
+	return res
+}
@@ -1,7 +1,7 @@
 package data
 
 import (
-	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/redis/go-redis/v9"
 	"github.com/surrealdb/surrealdb.go"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.opentelemetry.io/otel/trace"
@@ -12,16 +12,19 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+
+	pb "quiz/api/quizzes/v1"
 )
 
 // ProviderSet is data providers.
-var DataProviderSet = wire.NewSet(NewData, NewQuizRepo, NewQuestionsRepo)
+var DataProviderSet = wire.NewSet(NewData, NewQuizRepo, NewCachedQuizRepo, NewQuestionsRepo, NewCachedQuestionsRepo, NewProductsRepo, NewEntitlementsRepo, NewAttemptsRepo, NewResultsRepo, NewLeaderboardRepo, NewRoomBroker, NewTransaction, NewOutboxRepo, NewEventSink, NewWebhooksRepo, NewWebhookDeliveriesRepo, NewWebhookSender, NewAuditRepo, NewCollaboratorsRepo, NewGroupsRepo, NewAssignmentsRepo, NewMediaRepo, NewBlobStore, NewRateLimitStore, NewHealthRepo)
 
 // Data .
 type Data struct {
 	gorm    *gorm.DB
 	mongo   *mongo.Database
 	surreal *surrealdb.DB
+	redis   *redis.Client
 	logger  log.Logger
 }
 
@@ -31,8 +34,10 @@ func NewData(c *conf.Data, logger log.Logger, tp trace.TracerProvider) (*Data, f
 	var g *dep.Gorm
 	var m *dep.Mongo
 	var s *dep.Surreal
+	var r *dep.Redis
 	var mongoClean func()
 	var surrealClean func()
+	var redisClean func()
 	var err error
 	noDB := true
 
@@ -63,7 +68,25 @@ func NewData(c *conf.Data, logger log.Logger, tp trace.TracerProvider) (*Data, f
 		noDB = false
 	}
 
+	// redis is optional and never counts as the database
+	if c.GetRedis().GetAddr() != "" {
+		r, redisClean, err = dep.NewRedis(c, logger)
+		if err != nil {
+			lg.Warn("failed to connect to Redis", err)
+			if mongoClean != nil {
+				mongoClean()
+			}
+			if surrealClean != nil {
+				surrealClean()
+			}
+			return nil, nil, err
+		}
+	}
+
 	cleanup := func() {
+		if redisClean != nil {
+			redisClean()
+		}
 		if mongoClean != nil {
 			mongoClean()
 		}
@@ -74,7 +97,7 @@ func NewData(c *conf.Data, logger log.Logger, tp trace.TracerProvider) (*Data, f
 	}
 
 	if noDB {
-		return nil, nil, errors.InternalServer("no database configured", "no database configured")
+		return nil, nil, pb.ErrorInternal("no database configured")
 	}
 
 	data := &Data{logger: logger}
@@ -90,6 +113,10 @@ func NewData(c *conf.Data, logger log.Logger, tp trace.TracerProvider) (*Data, f
 		lg.Debug("Attaching SurrealDB")
 		data.surreal = s.DB
 	}
+	if r != nil {
+		lg.Debug("Attaching Redis")
+		data.redis = r.Client
+	}
 
 	return data, cleanup, nil
 }
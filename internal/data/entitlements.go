@@ -0,0 +1,175 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type Entitlement struct {
+	ID        bson.ObjectID `bson:"_id,omitempty"`
+	UserID    string        `bson:"user_id"`
+	ProductID string        `bson:"product_id"`
+	Source    string        `bson:"source"`
+	Reference string        `bson:"reference,omitempty"`
+	GrantedBy string        `bson:"granted_by"`
+	GrantedAt time.Time     `bson:"granted_at"`
+	ExpiresAt *time.Time    `bson:"expires_at"`
+	RevokedAt *time.Time    `bson:"revoked_at"`
+}
+
+type EntitlementsRepo struct {
+	coll   *mongo.Collection
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewEntitlementsRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.EntitlementsRepo {
+	return &EntitlementsRepo{
+		coll:   data.mongo.Collection("entitlements"),
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+// activeFilter matches entitlements that are neither revoked nor expired at the given time.
+func activeFilter(at time.Time) bson.M {
+	return bson.M{
+		"revoked_at": nil,
+		"$or": bson.A{
+			bson.M{"expires_at": nil},
+			bson.M{"expires_at": bson.M{"$gt": at}},
+		},
+	}
+}
+
+func (r *EntitlementsRepo) Save(ctx context.Context, e *biz.Entitlement) (*biz.Entitlement, error) {
+	ctx, span := r.tracer.Start(ctx, "data.EntitlementsRepo.Save")
+	defer span.End()
+
+	entitlement := EntitlementToData(e)
+	res, err := r.coll.InsertOne(ctx, entitlement)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	oid, err := insertedID(res)
+	if err != nil {
+		return nil, err
+	}
+	entitlement.ID = oid
+	return entitlement.Biz(), nil
+}
+
+func (r *EntitlementsRepo) GetByID(ctx context.Context, id string) (*biz.Entitlement, error) {
+	ctx, span := r.tracer.Start(ctx, "data.EntitlementsRepo.GetByID", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.findOne(ctx, bson.M{"_id": idObj})
+}
+
+func (r *EntitlementsRepo) GetByReference(ctx context.Context, reference string) (*biz.Entitlement, error) {
+	ctx, span := r.tracer.Start(ctx, "data.EntitlementsRepo.GetByReference", trace.WithAttributes(attribute.String("reference", reference)))
+	defer span.End()
+
+	return r.findOne(ctx, bson.M{"reference": reference})
+}
+
+func (r *EntitlementsRepo) ListByUser(ctx context.Context, userID string, activeAt *time.Time, pagination *biz.Pagination) ([]*biz.Entitlement, error) {
+	ctx, span := r.tracer.Start(ctx, "data.EntitlementsRepo.ListByUser", trace.WithAttributes(attribute.String("user_id", userID)))
+	defer span.End()
+
+	filter := bson.M{"user_id": userID}
+	if activeAt != nil {
+		for k, v := range activeFilter(*activeAt) {
+			filter[k] = v
+		}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "granted_at", Value: -1}}).
+		SetSkip(int64(pagination.Page * pagination.Size)).
+		SetLimit(int64(pagination.Size))
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var entitlements []Entitlement
+	if err := cur.All(ctx, &entitlements); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.Entitlement, 0, len(entitlements))
+	for _, e := range entitlements {
+		res = append(res, e.Biz())
+	}
+	return res, nil
+}
+
+func (r *EntitlementsRepo) HasActive(ctx context.Context, userID string, productIDs []string, at time.Time) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "data.EntitlementsRepo.HasActive", trace.WithAttributes(attribute.String("user_id", userID)))
+	defer span.End()
+
+	filter := activeFilter(at)
+	filter["user_id"] = userID
+	filter["product_id"] = bson.M{"$in": productIDs}
+	n, err := r.coll.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		r.log.Warn(err)
+		return false, dbError(err)
+	}
+	return n > 0, nil
+}
+
+func (r *EntitlementsRepo) Revoke(ctx context.Context, id string, at time.Time) (*biz.Entitlement, error) {
+	ctx, span := r.tracer.Start(ctx, "data.EntitlementsRepo.Revoke", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	return r.update(ctx, id, bson.M{"$set": bson.M{"revoked_at": at}})
+}
+
+func (r *EntitlementsRepo) SetExpiry(ctx context.Context, id string, expiresAt *time.Time) (*biz.Entitlement, error) {
+	ctx, span := r.tracer.Start(ctx, "data.EntitlementsRepo.SetExpiry", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	return r.update(ctx, id, bson.M{"$set": bson.M{"expires_at": expiresAt}})
+}
+
+func (r *EntitlementsRepo) update(ctx context.Context, id string, update bson.M) (*biz.Entitlement, error) {
+	idObj, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	res := r.coll.FindOneAndUpdate(ctx, bson.M{"_id": idObj}, update, opts)
+	return r.decode(res)
+}
+
+func (r *EntitlementsRepo) findOne(ctx context.Context, filter bson.M) (*biz.Entitlement, error) {
+	return r.decode(r.coll.FindOne(ctx, filter))
+}
+
+func (r *EntitlementsRepo) decode(res *mongo.SingleResult) (*biz.Entitlement, error) {
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorEntitlementNotFound("entitlement not found"))
+	}
+	var e Entitlement
+	if err := res.Decode(&e); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	return e.Biz(), nil
+}
@@ -0,0 +1,62 @@
+package data
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+// This file is where driver errors become API errors, so the repos never hand
+// mongo's own errors or messages to clients.
+
+// parseID reads a document ID, reporting a malformed one as INVALID_ID.
+func parseID(id string) (bson.ObjectID, error) {
+	oid, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return bson.NilObjectID, pb.ErrorInvalidId("%q is not a valid id", id)
+	}
+	return oid, nil
+}
+
+// insertedID returns the ID the driver assigned to an inserted document.
+func insertedID(res *mongo.InsertOneResult) (bson.ObjectID, error) {
+	oid, ok := res.InsertedID.(bson.ObjectID)
+	if !ok {
+		return bson.NilObjectID, pb.ErrorInternal("inserted id is not an object id")
+	}
+	return oid, nil
+}
+
+// findError reports a missing document as notFound and any other error as
+// dbError does.
+func findError(err error, notFound *errors.Error) error {
+	if stderrors.Is(err, mongo.ErrNoDocuments) {
+		return notFound
+	}
+	return dbError(err)
+}
+
+// dbError maps a driver error onto the error catalogue. Errors already in the
+// catalogue pass through unchanged.
+func dbError(err error) error {
+	var e *errors.Error
+	switch {
+	case err == nil:
+		return nil
+	case stderrors.As(err, &e):
+		return e
+	case stderrors.Is(err, context.Canceled):
+		return pb.ErrorClientClosed("request canceled").WithCause(err)
+	case mongo.IsDuplicateKeyError(err):
+		return pb.ErrorAlreadyExists("document already exists").WithCause(err)
+	case stderrors.Is(err, context.DeadlineExceeded), mongo.IsTimeout(err), mongo.IsNetworkError(err):
+		return pb.ErrorUnavailable("database unavailable").WithCause(err)
+	default:
+		return pb.ErrorInternal("database error").WithCause(err)
+	}
+}
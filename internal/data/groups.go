@@ -0,0 +1,203 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type Group struct {
+	ID          bson.ObjectID `bson:"_id,omitempty"`
+	OwnerID     string        `bson:"owner_id"`
+	Name        string        `bson:"name"`
+	Description string        `bson:"description"`
+	MemberIDs   []string      `bson:"member_ids"`
+	CreatedAt   time.Time     `bson:"created_at"`
+	UpdatedAt   time.Time     `bson:"updated_at"`
+}
+
+type GroupsRepo struct {
+	coll   *mongo.Collection
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewGroupsRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.GroupsRepo {
+	r := &GroupsRepo{
+		coll:   data.mongo.Collection("groups"),
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+	r.ensureIndexes()
+	return r
+}
+
+func (r *GroupsRepo) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "owner_id", Value: 1}}},
+		{Keys: bson.D{{Key: "member_ids", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Warnf("failed to create group indexes: %v", err)
+	}
+}
+
+func (r *GroupsRepo) Save(ctx context.Context, g *biz.Group) (*biz.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "data.GroupsRepo.Save")
+	defer span.End()
+
+	doc := GroupToData(g)
+	res, err := r.coll.InsertOne(ctx, doc)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	oid, err := insertedID(res)
+	if err != nil {
+		return nil, err
+	}
+	doc.ID = oid
+	return doc.Biz(), nil
+}
+
+func (r *GroupsRepo) GetByID(ctx context.Context, id string) (*biz.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "data.GroupsRepo.GetByID", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.decode(r.coll.FindOne(ctx, bson.M{"_id": idObj}))
+}
+
+func (r *GroupsRepo) List(ctx context.Context, userID string, pagination *biz.Pagination) ([]*biz.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "data.GroupsRepo.List", trace.WithAttributes(attribute.String("user_id", userID)))
+	defer span.End()
+
+	filter := bson.M{"$or": bson.A{bson.M{"owner_id": userID}, bson.M{"member_ids": userID}}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetSkip(int64(pagination.Page * pagination.Size)).
+		SetLimit(int64(pagination.Size))
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var groups []Group
+	if err := cur.All(ctx, &groups); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.Group, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, g.Biz())
+	}
+	return res, nil
+}
+
+func (r *GroupsRepo) MemberOf(ctx context.Context, userID string) ([]string, error) {
+	ctx, span := r.tracer.Start(ctx, "data.GroupsRepo.MemberOf", trace.WithAttributes(attribute.String("user_id", userID)))
+	defer span.End()
+
+	opts := options.Find().SetProjection(bson.M{"_id": 1})
+	cur, err := r.coll.Find(ctx, bson.M{"member_ids": userID}, opts)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var groups []Group
+	if err := cur.All(ctx, &groups); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	ids := make([]string, 0, len(groups))
+	for _, g := range groups {
+		ids = append(ids, g.ID.Hex())
+	}
+	return ids, nil
+}
+
+func (r *GroupsRepo) Update(ctx context.Context, g *biz.Group) (*biz.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "data.GroupsRepo.Update", trace.WithAttributes(attribute.String("id", g.ID)))
+	defer span.End()
+
+	return r.findOneAndUpdate(ctx, g.ID, bson.M{"$set": bson.M{
+		"name":        g.Name,
+		"description": g.Description,
+		"updated_at":  g.UpdatedAt,
+	}})
+}
+
+func (r *GroupsRepo) AddMembers(ctx context.Context, id string, userIDs []string) (*biz.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "data.GroupsRepo.AddMembers", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	return r.findOneAndUpdate(ctx, id, bson.M{
+		"$addToSet": bson.M{"member_ids": bson.M{"$each": userIDs}},
+		"$set":      bson.M{"updated_at": time.Now().UTC()},
+	})
+}
+
+func (r *GroupsRepo) RemoveMember(ctx context.Context, id string, userID string) (*biz.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "data.GroupsRepo.RemoveMember", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	return r.findOneAndUpdate(ctx, id, bson.M{
+		"$pull": bson.M{"member_ids": userID},
+		"$set":  bson.M{"updated_at": time.Now().UTC()},
+	})
+}
+
+func (r *GroupsRepo) Delete(ctx context.Context, id string) error {
+	ctx, span := r.tracer.Start(ctx, "data.GroupsRepo.Delete", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	res, err := r.coll.DeleteOne(ctx, bson.M{"_id": idObj})
+	if err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	if res.DeletedCount == 0 {
+		return pb.ErrorGroupNotFound("group not found")
+	}
+	return nil
+}
+
+func (r *GroupsRepo) findOneAndUpdate(ctx context.Context, id string, update bson.M) (*biz.Group, error) {
+	idObj, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	return r.decode(r.coll.FindOneAndUpdate(ctx, bson.M{"_id": idObj}, update, opts))
+}
+
+func (r *GroupsRepo) decode(res *mongo.SingleResult) (*biz.Group, error) {
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorGroupNotFound("group not found"))
+	}
+	var g Group
+	if err := res.Decode(&g); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	return g.Biz(), nil
+}
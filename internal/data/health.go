@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"quiz/internal/biz"
+)
+
+type healthCheck struct {
+	name string
+	ping func(ctx context.Context) error
+}
+
+// HealthRepo pings the databases NewData connected to.
+type HealthRepo struct {
+	checks []healthCheck
+}
+
+func NewHealthRepo(data *Data) biz.HealthRepo {
+	r := &HealthRepo{}
+	if data.gorm != nil {
+		r.checks = append(r.checks, healthCheck{"postgres", func(ctx context.Context) error {
+			db, err := data.gorm.DB()
+			if err != nil {
+				return err
+			}
+			return db.PingContext(ctx)
+		}})
+	}
+	if data.mongo != nil {
+		r.checks = append(r.checks, healthCheck{"mongo", func(ctx context.Context) error {
+			return data.mongo.Client().Ping(ctx, nil)
+		}})
+	}
+	if data.surreal != nil {
+		r.checks = append(r.checks, healthCheck{"surreal", func(context.Context) error {
+			_, err := data.surreal.Version()
+			return err
+		}})
+	}
+	if data.redis != nil {
+		r.checks = append(r.checks, healthCheck{"redis", func(ctx context.Context) error {
+			return data.redis.Ping(ctx).Err()
+		}})
+	}
+	return r
+}
+
+func (r *HealthRepo) Check(ctx context.Context) []biz.DependencyHealth {
+	res := make([]biz.DependencyHealth, len(r.checks))
+	var wg sync.WaitGroup
+	for i, c := range r.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res[i] = ping(ctx, c)
+		}()
+	}
+	wg.Wait()
+	return res
+}
+
+// ping runs c, giving up when ctx is done even if c does not, as the
+// SurrealDB client takes no context.
+func ping(ctx context.Context, c healthCheck) biz.DependencyHealth {
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- c.ping(ctx)
+	}()
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	h := biz.DependencyHealth{Name: c.name, Healthy: err == nil, Latency: time.Since(start)}
+	if err != nil {
+		h.Error = err.Error()
+	}
+	return h
+}
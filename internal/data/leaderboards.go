@@ -0,0 +1,256 @@
+package data
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	// a member's score packs the quiz score in hundredths above the inverted
+	// completion time in milliseconds, so one descending sort ranks by score
+	// and then by speed. Times beyond ~27 hours all tie.
+	leaderboardTimeSlots = 1e8
+
+	dailyRetention  = 31 * 24 * time.Hour
+	weeklyRetention = 365 * 24 * time.Hour
+
+	// rebuildRetention bounds how long the staged boards of a rebuild that
+	// died halfway are kept.
+	rebuildRetention = time.Hour
+)
+
+// LeaderboardRepo keeps one sorted set per quiz and window, keyed by user ID.
+type LeaderboardRepo struct {
+	rdb    *redis.Client
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewLeaderboardRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.LeaderboardRepo {
+	return &LeaderboardRepo{
+		rdb:    data.redis,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+var errLeaderboardUnavailable = pb.ErrorUnavailable("leaderboard unavailable: redis is not configured")
+
+// leaderboardKey returns the key of a board and when it may be dropped. The
+// quiz ID is a hash tag so all boards of a quiz live on one cluster slot.
+func leaderboardKey(quizID string, window biz.LeaderboardWindow, at time.Time) (string, time.Time) {
+	at = at.UTC()
+	switch window {
+	case biz.LeaderboardWeekly:
+		year, week := at.ISOWeek()
+		monday := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -((int(at.Weekday()) + 6) % 7))
+		return fmt.Sprintf("leaderboard:{%s}:week:%04d-W%02d", quizID, year, week), monday.AddDate(0, 0, 7).Add(weeklyRetention)
+	case biz.LeaderboardDaily:
+		day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+		return fmt.Sprintf("leaderboard:{%s}:day:%s", quizID, day.Format(time.DateOnly)), day.AddDate(0, 0, 1).Add(dailyRetention)
+	default:
+		return fmt.Sprintf("leaderboard:{%s}:all", quizID), time.Time{}
+	}
+}
+
+func encodeLeaderboardScore(score float32, spent time.Duration) float64 {
+	ms := min(max(spent.Milliseconds(), 0), leaderboardTimeSlots-1)
+	return math.Round(float64(score)*100)*leaderboardTimeSlots + float64(leaderboardTimeSlots-1-ms)
+}
+
+func decodeLeaderboardScore(v float64) (float32, time.Duration) {
+	points := math.Floor(v / leaderboardTimeSlots)
+	ms := leaderboardTimeSlots - 1 - (v - points*leaderboardTimeSlots)
+	return float32(points / 100), time.Duration(ms) * time.Millisecond
+}
+
+// board is one board an entry goes into.
+type board struct {
+	key       string
+	expiresAt time.Time
+}
+
+// boards returns the boards of quizID containing at that have not expired.
+func boards(quizID string, at time.Time) []board {
+	now := time.Now()
+	var res []board
+	for _, window := range []biz.LeaderboardWindow{biz.LeaderboardAllTime, biz.LeaderboardWeekly, biz.LeaderboardDaily} {
+		key, expiresAt := leaderboardKey(quizID, window, at)
+		if !expiresAt.IsZero() && expiresAt.Before(now) {
+			continue
+		}
+		res = append(res, board{key: key, expiresAt: expiresAt})
+	}
+	return res
+}
+
+func (r *LeaderboardRepo) Record(ctx context.Context, quizID string, entry *biz.LeaderboardEntry, at time.Time) error {
+	ctx, span := r.tracer.Start(ctx, "data.LeaderboardRepo.Record", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	if r.rdb == nil {
+		return nil
+	}
+	member := redis.Z{Score: encodeLeaderboardScore(entry.Score, entry.TimeSpent), Member: entry.UserID}
+	pipe := r.rdb.TxPipeline()
+	for _, b := range boards(quizID, at) {
+		// GT keeps the user's best attempt
+		pipe.ZAddGT(ctx, b.key, member)
+		if !b.expiresAt.IsZero() {
+			pipe.ExpireAt(ctx, b.key, b.expiresAt)
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	return nil
+}
+
+// Rebuild stages the new boards under keys of their own and renames them over
+// the live ones in a single transaction, which also drops the boards left
+// without entries. The staged keys share the hash tag of the live ones, so a
+// cluster keeps them on one slot. Attempts recorded while the rebuild runs
+// may be replaced by the staged boards if fill read past them.
+func (r *LeaderboardRepo) Rebuild(ctx context.Context, quizID string, fill func(add func(entry *biz.LeaderboardEntry, at time.Time) error) error) error {
+	ctx, span := r.tracer.Start(ctx, "data.LeaderboardRepo.Rebuild", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	if r.rdb == nil {
+		return errLeaderboardUnavailable
+	}
+	prefix := "rebuild:" + uuid.NewString() + ":"
+	// staged maps the live key of each staged board to whether it is kept
+	// for good
+	staged := make(map[string]bool)
+	err := fill(func(entry *biz.LeaderboardEntry, at time.Time) error {
+		member := redis.Z{Score: encodeLeaderboardScore(entry.Score, entry.TimeSpent), Member: entry.UserID}
+		pipe := r.rdb.TxPipeline()
+		for _, b := range boards(quizID, at) {
+			pipe.ZAddGT(ctx, prefix+b.key, member)
+			if b.expiresAt.IsZero() {
+				pipe.Expire(ctx, prefix+b.key, rebuildRetention)
+			} else {
+				pipe.ExpireAt(ctx, prefix+b.key, b.expiresAt)
+			}
+			staged[b.key] = b.expiresAt.IsZero()
+		}
+		_, err := pipe.Exec(ctx)
+		return err
+	})
+	if err == nil {
+		err = r.swap(ctx, quizID, prefix, staged)
+	}
+	if err != nil {
+		r.log.Warn(err)
+		r.dropStaged(ctx, prefix, staged)
+		return dbError(err)
+	}
+	return nil
+}
+
+func (r *LeaderboardRepo) swap(ctx context.Context, quizID string, prefix string, staged map[string]bool) error {
+	iter := r.rdb.Scan(ctx, 0, fmt.Sprintf("leaderboard:{%s}:*", quizID), 100).Iterator()
+	var live []string
+	for iter.Next(ctx) {
+		live = append(live, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	pipe := r.rdb.TxPipeline()
+	for _, key := range live {
+		if _, ok := staged[key]; !ok {
+			pipe.Del(ctx, key)
+		}
+	}
+	for key, permanent := range staged {
+		pipe.Rename(ctx, prefix+key, key)
+		if permanent {
+			pipe.Persist(ctx, key)
+		}
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// dropStaged removes what a failed rebuild staged; whatever it misses expires.
+func (r *LeaderboardRepo) dropStaged(ctx context.Context, prefix string, staged map[string]bool) {
+	if len(staged) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(staged))
+	for key := range staged {
+		keys = append(keys, prefix+key)
+	}
+	if err := r.rdb.Del(context.WithoutCancel(ctx), keys...).Err(); err != nil {
+		r.log.Warn(err)
+	}
+}
+
+func (r *LeaderboardRepo) Top(ctx context.Context, quizID string, window biz.LeaderboardWindow, at time.Time, pagination *biz.Pagination) ([]*biz.LeaderboardEntry, error) {
+	ctx, span := r.tracer.Start(ctx, "data.LeaderboardRepo.Top", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	if r.rdb == nil {
+		return nil, errLeaderboardUnavailable
+	}
+	key, _ := leaderboardKey(quizID, window, at)
+	start := int64(pagination.Page * pagination.Size)
+	members, err := r.rdb.ZRevRangeWithScores(ctx, key, start, start+int64(pagination.Size)-1).Result()
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.LeaderboardEntry, 0, len(members))
+	for i, m := range members {
+		userID, _ := m.Member.(string)
+		score, spent := decodeLeaderboardScore(m.Score)
+		res = append(res, &biz.LeaderboardEntry{
+			Rank:      start + int64(i) + 1,
+			UserID:    userID,
+			Score:     score,
+			TimeSpent: spent,
+		})
+	}
+	return res, nil
+}
+
+func (r *LeaderboardRepo) Rank(ctx context.Context, quizID string, window biz.LeaderboardWindow, at time.Time, userID string) (*biz.LeaderboardEntry, error) {
+	ctx, span := r.tracer.Start(ctx, "data.LeaderboardRepo.Rank", trace.WithAttributes(attribute.String("quiz_id", quizID), attribute.String("user_id", userID)))
+	defer span.End()
+
+	if r.rdb == nil {
+		return nil, errLeaderboardUnavailable
+	}
+	key, _ := leaderboardKey(quizID, window, at)
+	pipe := r.rdb.Pipeline()
+	rank := pipe.ZRevRank(ctx, key, userID)
+	value := pipe.ZScore(ctx, key, userID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		if stderrors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	score, spent := decodeLeaderboardScore(value.Val())
+	return &biz.LeaderboardEntry{
+		Rank:      rank.Val() + 1,
+		UserID:    userID,
+		Score:     score,
+		TimeSpent: spent,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"quiz/internal/biz"
+)
+
+func TestLeaderboardScoreRoundTrip(t *testing.T) {
+	tests := []struct {
+		score     float32
+		spent     time.Duration
+		wantScore float32
+		wantSpent time.Duration
+	}{
+		{0, 0, 0, 0},
+		{1, 90 * time.Second, 1, 90 * time.Second},
+		{0.75, 1234 * time.Millisecond, 0.75, 1234 * time.Millisecond},
+		// scores keep hundredths and times keep milliseconds
+		{0.333, 1500*time.Microsecond + 10*time.Second, 0.33, 10001 * time.Millisecond},
+		{0.5, -time.Second, 0.5, 0},
+		{0.5, 30 * time.Hour, 0.5, (leaderboardTimeSlots - 1) * time.Millisecond},
+	}
+	for _, tt := range tests {
+		score, spent := decodeLeaderboardScore(encodeLeaderboardScore(tt.score, tt.spent))
+		if score != tt.wantScore || spent != tt.wantSpent {
+			t.Errorf("encode(%v, %v) decodes to %v, %v; want %v, %v", tt.score, tt.spent, score, spent, tt.wantScore, tt.wantSpent)
+		}
+	}
+}
+
+func TestLeaderboardScoreOrder(t *testing.T) {
+	type result struct {
+		score float32
+		spent time.Duration
+	}
+	tests := []struct {
+		name          string
+		better, worse result
+	}{
+		{"higher score", result{0.9, time.Hour}, result{0.8, time.Second}},
+		{"by a hundredth", result{0.51, 20 * time.Hour}, result{0.5, 0}},
+		{"faster on a tie", result{0.8, time.Minute}, result{0.8, time.Minute + time.Millisecond}},
+		{"full marks over none", result{1, 26 * time.Hour}, result{0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			better := encodeLeaderboardScore(tt.better.score, tt.better.spent)
+			worse := encodeLeaderboardScore(tt.worse.score, tt.worse.spent)
+			if better <= worse {
+				t.Errorf("%v ranks at or below %v", tt.better, tt.worse)
+			}
+		})
+	}
+}
+
+func TestLeaderboardScoreSlowTimesTie(t *testing.T) {
+	a := encodeLeaderboardScore(0.7, 28*time.Hour)
+	b := encodeLeaderboardScore(0.7, 40*time.Hour)
+	if a != b {
+		t.Errorf("times past the last slot rank apart: %v, %v", a, b)
+	}
+}
+
+func TestLeaderboardKey(t *testing.T) {
+	at := time.Date(2026, time.January, 1, 15, 4, 5, 0, time.UTC) // a Thursday in ISO week 1
+	tests := []struct {
+		window  biz.LeaderboardWindow
+		want    string
+		expires time.Time
+	}{
+		{biz.LeaderboardAllTime, "leaderboard:{q1}:all", time.Time{}},
+		{biz.LeaderboardWeekly, "leaderboard:{q1}:week:2026-W01", time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC).Add(weeklyRetention)},
+		{biz.LeaderboardDaily, "leaderboard:{q1}:day:2026-01-01", time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC).Add(dailyRetention)},
+	}
+	for _, tt := range tests {
+		key, expires := leaderboardKey("q1", tt.window, at)
+		if key != tt.want || !expires.Equal(tt.expires) {
+			t.Errorf("window %d: %s expiring %v, want %s expiring %v", tt.window, key, expires, tt.want, tt.expires)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type Media struct {
+	ID          bson.ObjectID `bson:"_id,omitempty"`
+	OwnerID     string        `bson:"owner_id"`
+	Filename    string        `bson:"filename"`
+	ContentType string        `bson:"content_type"`
+	Size        int64         `bson:"size"`
+	Checksum    string        `bson:"sha256"`
+	Key         string        `bson:"key"`
+	CreatedAt   time.Time     `bson:"created_at"`
+}
+
+// Attachment is embedded in questions and their answers.
+type Attachment struct {
+	Kind     int32  `bson:"kind"`
+	MediaID  string `bson:"media_id,omitempty"`
+	Code     string `bson:"code,omitempty"`
+	Language string `bson:"language,omitempty"`
+	Caption  string `bson:"caption,omitempty"`
+}
+
+// MediaRepo keeps the metadata of uploaded files in the "media" collection;
+// their content is in the blob store.
+type MediaRepo struct {
+	coll   *mongo.Collection
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewMediaRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.MediaRepo {
+	return &MediaRepo{
+		coll:   data.mongo.Collection("media"),
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (r *MediaRepo) Save(ctx context.Context, m *biz.Media) (*biz.Media, error) {
+	ctx, span := r.tracer.Start(ctx, "data.MediaRepo.Save")
+	defer span.End()
+
+	doc := MediaToData(m)
+	res, err := r.coll.InsertOne(ctx, doc)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	oid, err := insertedID(res)
+	if err != nil {
+		return nil, err
+	}
+	doc.ID = oid
+	return doc.Biz(), nil
+}
+
+func (r *MediaRepo) GetByID(ctx context.Context, id string) (*biz.Media, error) {
+	ctx, span := r.tracer.Start(ctx, "data.MediaRepo.GetByID", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	res := r.coll.FindOne(ctx, bson.M{"_id": idObj})
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorMediaNotFound("media not found"))
+	}
+	var m Media
+	if err := res.Decode(&m); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	return m.Biz(), nil
+}
+
+func (r *MediaRepo) GetByIDs(ctx context.Context, ids []string) ([]*biz.Media, error) {
+	ctx, span := r.tracer.Start(ctx, "data.MediaRepo.GetByIDs", trace.WithAttributes(attribute.StringSlice("ids", ids)))
+	defer span.End()
+
+	oids := make([]bson.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		oid, err := parseID(id)
+		if err != nil {
+			return nil, err
+		}
+		oids = append(oids, oid)
+	}
+	cur, err := r.coll.Find(ctx, bson.M{"_id": bson.M{"$in": oids}})
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var docs []Media
+	if err := cur.All(ctx, &docs); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.Media, 0, len(docs))
+	for _, m := range docs {
+		res = append(res, m.Biz())
+	}
+	return res, nil
+}
+
+func (r *MediaRepo) Delete(ctx context.Context, id string) error {
+	ctx, span := r.tracer.Start(ctx, "data.MediaRepo.Delete", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	res, err := r.coll.DeleteOne(ctx, bson.M{"_id": idObj})
+	if err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	if res.DeletedCount == 0 {
+		return pb.ErrorMediaNotFound("media not found")
+	}
+	return nil
+}
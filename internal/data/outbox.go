@@ -0,0 +1,123 @@
+package data
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+)
+
+// published events are kept this long for inspection
+const outboxRetention = 7 * 24 * time.Hour
+
+type OutboxEvent struct {
+	ID          bson.ObjectID `bson:"_id,omitempty"`
+	EventID     string        `bson:"event_id"`
+	Type        string        `bson:"type"`
+	AggregateID string        `bson:"aggregate_id"`
+	OccurredAt  time.Time     `bson:"occurred_at"`
+	Data        string        `bson:"data"`
+	PublishedAt *time.Time    `bson:"published_at"`
+	// set while a relay holds the event
+	ClaimedUntil *time.Time `bson:"claimed_until,omitempty"`
+}
+
+type OutboxRepo struct {
+	coll   *mongo.Collection
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewOutboxRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.OutboxRepo {
+	r := &OutboxRepo{
+		coll:   data.mongo.Collection("outbox"),
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+	r.ensureIndexes()
+	return r
+}
+
+func (r *OutboxRepo) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "published_at", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "event_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{
+			Keys:    bson.D{{Key: "published_at", Value: 1}},
+			Options: options.Index().SetName("published_at_ttl").SetExpireAfterSeconds(int32(outboxRetention / time.Second)),
+		},
+	})
+	if err != nil {
+		r.log.Warnf("failed to create outbox indexes: %v", err)
+	}
+}
+
+func (r *OutboxRepo) Add(ctx context.Context, events ...*biz.DomainEvent) error {
+	ctx, span := r.tracer.Start(ctx, "data.OutboxRepo.Add", trace.WithAttributes(attribute.Int("events", len(events))))
+	defer span.End()
+
+	docs := make([]any, 0, len(events))
+	for _, e := range events {
+		docs = append(docs, OutboxEventToData(e))
+	}
+	if _, err := r.coll.InsertMany(ctx, docs); err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	return nil
+}
+
+func (r *OutboxRepo) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*biz.DomainEvent, error) {
+	ctx, span := r.tracer.Start(ctx, "data.OutboxRepo.Claim")
+	defer span.End()
+
+	filter := bson.M{
+		"published_at": nil,
+		"$or": bson.A{
+			bson.M{"claimed_until": nil},
+			bson.M{"claimed_until": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"claimed_until": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetReturnDocument(options.After)
+	var res []*biz.DomainEvent
+	for len(res) < limit {
+		var d OutboxEvent
+		err := r.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&d)
+		if stderrors.Is(err, mongo.ErrNoDocuments) {
+			break
+		}
+		if err != nil {
+			r.log.Warn(err)
+			return res, dbError(err)
+		}
+		res = append(res, d.Biz())
+	}
+	span.SetAttributes(attribute.Int("events", len(res)))
+	return res, nil
+}
+
+func (r *OutboxRepo) MarkPublished(ctx context.Context, ids []string) error {
+	ctx, span := r.tracer.Start(ctx, "data.OutboxRepo.MarkPublished", trace.WithAttributes(attribute.Int("events", len(ids))))
+	defer span.End()
+
+	now := time.Now().UTC()
+	_, err := r.coll.UpdateMany(ctx, bson.M{"event_id": bson.M{"$in": ids}}, bson.M{"$set": bson.M{"published_at": now}})
+	if err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	return nil
+}
@@ -3,7 +3,6 @@ package data
 import (
 	"context"
 	"fmt"
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
@@ -12,10 +11,12 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
 )
 
 type Products struct {
-	ID          bson.ObjectID     `bson:"_id"`
+	ID          bson.ObjectID     `bson:"_id,omitempty"`
 	Name        string            `bson:"name"`
 	Description string            `bson:"desc"`
 	Price       float32           `bson:"price"`
@@ -24,6 +25,7 @@ type Products struct {
 	Attributes  map[string]string `bson:"attributes"`
 	Thumbnail   string            `bson:"thumbnail"`
 	Images      []string          `bson:"images"`
+	QuizIDs     []string          `bson:"quiz_ids"`
 }
 
 type productsRepo struct {
@@ -55,6 +57,7 @@ func (r productsRepo) Save(ctx context.Context, p *biz.Product) (string, error)
 		Tags:        p.Tags,
 		Attributes:  p.Attributes,
 		Images:      p.Images,
+		QuizIDs:     p.QuizIDs,
 	}
 	if p.Thumbnail != nil {
 		product.Thumbnail = *p.Thumbnail
@@ -62,10 +65,13 @@ func (r productsRepo) Save(ctx context.Context, p *biz.Product) (string, error)
 	res, err := r.coll.InsertOne(ctx, product)
 	if err != nil {
 		r.log.Error("failed to save product", err)
+		return "", dbError(err)
+	}
+	oid, err := insertedID(res)
+	if err != nil {
 		return "", err
 	}
-	id := res.InsertedID.(bson.ObjectID).Hex()
-	return id, nil
+	return oid.Hex(), nil
 }
 
 func (r productsRepo) GetByID(ctx context.Context, id string) (*biz.Product, error) {
@@ -75,38 +81,22 @@ func (r productsRepo) GetByID(ctx context.Context, id string) (*biz.Product, err
 		Key:   "id",
 		Value: attribute.StringValue(id),
 	})
-	idObj, err := bson.ObjectIDFromHex(id)
+	idObj, err := parseID(id)
 	if err != nil {
-		r.log.Error("failed to parse product id", err)
 		return nil, err
 	}
 
 	res := r.coll.FindOne(ctx, bson.M{"_id": idObj})
-	if res.Err() != nil {
-		r.log.Error("failed to get product", res.Err())
-		return nil, res.Err()
-	}
-	if res.Err() != nil {
-		r.log.Error("failed to get product", res.Err())
-		return nil, res.Err()
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorProductNotFound("product not found"))
 	}
 	var p Products
 	err = res.Decode(&p)
 	if err != nil {
 		r.log.Error("failed to decode product", err)
-		return nil, err
+		return nil, dbError(err)
 	}
-	return &biz.Product{
-		ID:          p.ID.Hex(),
-		Name:        p.Name,
-		Description: p.Description,
-		Price:       p.Price,
-		Category:    p.Category,
-		Tags:        p.Tags,
-		Attributes:  p.Attributes,
-		Thumbnail:   &p.Thumbnail,
-		Images:      p.Images,
-	}, nil
+	return p.Biz(), nil
 }
 
 func (r productsRepo) List(ctx context.Context, pagination *biz.Pagination) ([]*biz.Product, error) {
@@ -130,26 +120,16 @@ func (r productsRepo) List(ctx context.Context, pagination *biz.Pagination) ([]*
 	cur, err := r.coll.Find(ctx, bson.M{}, opts)
 	if err != nil {
 		r.log.Error("failed to list products", err)
-		return nil, err
+		return nil, dbError(err)
 	}
 	var res []*biz.Product
 	for cur.Next(ctx) {
 		var p Products
 		if err := cur.Decode(&p); err != nil {
 			r.log.Error("failed to decode product", err)
-			return nil, err
+			return nil, dbError(err)
 		}
-		res = append(res, &biz.Product{
-			ID:          p.ID.Hex(),
-			Name:        p.Name,
-			Description: p.Description,
-			Price:       p.Price,
-			Category:    p.Category,
-			Tags:        p.Tags,
-			Attributes:  p.Attributes,
-			Thumbnail:   &p.Thumbnail,
-			Images:      p.Images,
-		})
+		res = append(res, p.Biz())
 	}
 	return res, nil
 }
@@ -161,44 +141,34 @@ func (r productsRepo) Update(ctx context.Context, p *biz.Product) (*biz.Product,
 		Key:   "product",
 		Value: attribute.StringValue(fmt.Sprintf("Name: %s, Desc %s, Category: %s, Price: %f", p.Name, p.Description, p.Category, p.Price)),
 	})
-	uid, err := bson.ObjectIDFromHex(p.ID)
+	uid, err := parseID(p.ID)
 	if err != nil {
-		r.log.Error("failed to parse product id", err)
 		return nil, err
 	}
-	product := Products{
-		ID:          uid,
-		Name:        p.Name,
-		Description: p.Description,
-		Price:       p.Price,
-		Category:    p.Category,
-		Tags:        p.Tags,
-		Attributes:  p.Attributes,
-		Images:      p.Images,
+	set := bson.M{
+		"name":       p.Name,
+		"desc":       p.Description,
+		"price":      p.Price,
+		"category":   p.Category,
+		"tags":       p.Tags,
+		"attributes": p.Attributes,
+		"images":     p.Images,
 	}
 	if p.Thumbnail != nil {
-		product.Thumbnail = *p.Thumbnail
+		set["thumbnail"] = *p.Thumbnail
 	}
-	res, err := r.coll.ReplaceOne(ctx, bson.M{"_id": product.ID}, product)
-	if err != nil {
-		r.log.Error("failed to update product", err)
-		return nil, err
+	// quiz links are managed through LinkQuizzes/UnlinkQuiz, so they are left untouched here
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	res := r.coll.FindOneAndUpdate(ctx, bson.M{"_id": uid}, bson.M{"$set": set}, opts)
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorProductNotFound("product not found"))
 	}
-	if res.ModifiedCount == 0 {
-		r.log.Error("failed to update product", "err was empty but insertions failed")
-		return nil, errors.InternalServer("failed to update product", "err was empty but insertions failed")
+	var product Products
+	if err := res.Decode(&product); err != nil {
+		r.log.Error("failed to decode product", err)
+		return nil, dbError(err)
 	}
-	return &biz.Product{
-		ID:          product.ID.Hex(),
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Category:    product.Category,
-		Tags:        product.Tags,
-		Attributes:  product.Attributes,
-		Thumbnail:   &product.Thumbnail,
-		Images:      product.Images,
-	}, nil
+	return product.Biz(), nil
 }
 
 func (r productsRepo) Delete(ctx context.Context, id string) (string, error) {
@@ -208,19 +178,17 @@ func (r productsRepo) Delete(ctx context.Context, id string) (string, error) {
 		Key:   "id",
 		Value: attribute.StringValue(id),
 	})
-	idObj, err := bson.ObjectIDFromHex(id)
+	idObj, err := parseID(id)
 	if err != nil {
-		r.log.Error("failed to parse product id", err)
 		return "", err
 	}
 	res, err := r.coll.DeleteOne(ctx, bson.M{"_id": idObj})
 	if err != nil {
 		r.log.Error("failed to delete product", err)
-		return "", err
+		return "", dbError(err)
 	}
 	if res.DeletedCount == 0 {
-		r.log.Error("failed to delete product", "err was empty but insertions failed")
-		return "", errors.InternalServer("failed to delete product", "err was empty but insertions failed")
+		return "", pb.ErrorProductNotFound("product not found")
 	}
 	return id, nil
 }
@@ -241,25 +209,79 @@ func (r productsRepo) Search(ctx context.Context, keyword string, pagination *bi
 	cur, err := r.coll.Find(ctx, bson.M{"$text": bson.M{"$search": keyword}}, opts)
 	if err != nil {
 		r.log.Error("failed to search products", err)
-		return nil, err
+		return nil, dbError(err)
 	}
 	if err := cur.All(ctx, &products); err != nil {
 		r.log.Error("failed to decode products", err)
-		return nil, err
+		return nil, dbError(err)
 	}
 	var res []*biz.Product
 	for _, p := range products {
-		res = append(res, &biz.Product{
-			ID:          p.ID.Hex(),
-			Name:        p.Name,
-			Description: p.Description,
-			Price:       p.Price,
-			Category:    p.Category,
-			Tags:        p.Tags,
-			Attributes:  p.Attributes,
-			Thumbnail:   &p.Thumbnail,
-			Images:      p.Images,
-		})
+		res = append(res, p.Biz())
+	}
+	return res, nil
+}
+
+func (r productsRepo) ListByQuizID(ctx context.Context, quizID string) ([]*biz.Product, error) {
+	_, span := otel.Tracer("products").Start(ctx, "ListByQuizID")
+	defer span.End()
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "quiz_id",
+		Value: attribute.StringValue(quizID),
+	})
+	var products []Products
+	cur, err := r.coll.Find(ctx, bson.M{"quiz_ids": quizID})
+	if err != nil {
+		r.log.Error("failed to list products by quiz", err)
+		return nil, dbError(err)
+	}
+	if err := cur.All(ctx, &products); err != nil {
+		r.log.Error("failed to decode products", err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.Product, 0, len(products))
+	for _, p := range products {
+		res = append(res, p.Biz())
 	}
 	return res, nil
 }
+
+func (r productsRepo) LinkQuizzes(ctx context.Context, id string, quizIDs []string) (*biz.Product, error) {
+	_, span := otel.Tracer("products").Start(ctx, "LinkQuizzes")
+	defer span.End()
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.StringValue(id),
+	})
+	update := bson.M{"$addToSet": bson.M{"quiz_ids": bson.M{"$each": quizIDs}}}
+	return r.updateLinks(ctx, id, update)
+}
+
+func (r productsRepo) UnlinkQuiz(ctx context.Context, id string, quizID string) (*biz.Product, error) {
+	_, span := otel.Tracer("products").Start(ctx, "UnlinkQuiz")
+	defer span.End()
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "id",
+		Value: attribute.StringValue(id),
+	})
+	update := bson.M{"$pull": bson.M{"quiz_ids": quizID}}
+	return r.updateLinks(ctx, id, update)
+}
+
+func (r productsRepo) updateLinks(ctx context.Context, id string, update bson.M) (*biz.Product, error) {
+	idObj, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	res := r.coll.FindOneAndUpdate(ctx, bson.M{"_id": idObj}, update, opts)
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorProductNotFound("product not found"))
+	}
+	var p Products
+	if err := res.Decode(&p); err != nil {
+		r.log.Error("failed to decode product", err)
+		return nil, dbError(err)
+	}
+	return p.Biz(), nil
+}
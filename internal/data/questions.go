@@ -2,9 +2,7 @@ package data
 
 import (
 	"context"
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/jinzhu/copier"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
@@ -12,13 +10,21 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"quiz/internal/biz"
 	"time"
+
+	pb "quiz/api/quizzes/v1"
 )
 
 type Answer struct {
-	ID          string `bson:"id,omitempty"`
-	Text        string `bson:"answer"`
-	IsCorrect   bool   `bson:"is_correct"`
-	Explanation string `bson:"explanation"`
+	ID                      string            `bson:"id,omitempty"`
+	Text                    string            `bson:"answer"`
+	TextTranslations        map[string]string `bson:"answer_translations,omitempty"`
+	IsCorrect               bool              `bson:"is_correct"`
+	Explanation             string            `bson:"explanation"`
+	ExplanationTranslations map[string]string `bson:"explanation_translations,omitempty"`
+	Pinned                  bool              `bson:"pinned,omitempty"`
+	Media                   []Attachment      `bson:"media,omitempty"`
+	Format                  int32             `bson:"format,omitempty"`
+	ExplanationFormat       int32             `bson:"explanation_format,omitempty"`
 }
 
 type Question struct {
@@ -29,12 +35,19 @@ type Question struct {
 	Order      float64       `bson:"order"`
 	Answers    []Answer      `bson:"answers"`
 	Hint       string        `bson:"hint"`
-	CreatedBy  string        `bson:"created_by"`
-	UpdatedBy  string        `bson:"updated_by"`
-	DeletedBy  string        `bson:"deleted_by"`
-	CreatedAt  string        `bson:"created_at"`
-	UpdatedAt  string        `bson:"updated_at"`
-	DeletedAt  string        `bson:"deleted_at"`
+	Tags       []string      `bson:"tags"`
+	Media      []Attachment  `bson:"media,omitempty"`
+	Format     int32         `bson:"format,omitempty"`
+
+	QuestionTranslations map[string]string `bson:"question_translations,omitempty"`
+	HintTranslations     map[string]string `bson:"hint_translations,omitempty"`
+
+	CreatedBy string `bson:"created_by"`
+	UpdatedBy string `bson:"updated_by"`
+	DeletedBy string `bson:"deleted_by"`
+	CreatedAt string `bson:"created_at"`
+	UpdatedAt string `bson:"updated_at"`
+	DeletedAt string `bson:"deleted_at"`
 }
 
 type QuestionsRepo struct {
@@ -44,7 +57,7 @@ type QuestionsRepo struct {
 	table  string
 }
 
-func NewQuestionsRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.QuestionsRepo {
+func NewQuestionsRepo(data *Data, logger log.Logger, tracer trace.Tracer) *QuestionsRepo {
 	return &QuestionsRepo{
 		coll:   data.mongo.Collection("questions"),
 		log:    log.NewHelper(logger),
@@ -58,30 +71,24 @@ func (r *QuestionsRepo) Save(ctx context.Context, q *biz.Question) (*biz.Questio
 	defer span.End()
 	createdAt := time.Now().String()
 
-	var question Question
-	err := copier.Copy(&question, q)
-	if err != nil {
-		r.log.Warn(err)
-		return nil, err
-	}
+	question := QuestionToData(q)
 	question.CreatedAt = createdAt
 	question.UpdatedAt = createdAt
 
 	res, err := r.coll.InsertOne(ctx, question)
 	if err != nil {
 		r.log.Warn(err)
-		return nil, err
+		return nil, dbError(err)
 	}
 
-	if oid, ok := res.InsertedID.(bson.ObjectID); ok {
-		hex := oid.Hex()
-		r.log.Debugf("inserted id is object id: %s", hex)
-		resQuestion := question.Biz()
-		resQuestion.ID = hex
-		r.log.Debugf("inserted question: %+v", resQuestion)
-		return resQuestion, nil
+	oid, err := insertedID(res)
+	if err != nil {
+		return nil, err
 	}
-	return nil, errors.InternalServer("inserted id is not object id", "inserted id is not object id")
+	resQuestion := question.Biz()
+	resQuestion.ID = oid.Hex()
+	r.log.Debugf("inserted question: %+v", resQuestion)
+	return resQuestion, nil
 }
 
 func (r QuestionsRepo) GetByID(ctx context.Context, id string) (*biz.Question, error) {
@@ -91,25 +98,19 @@ func (r QuestionsRepo) GetByID(ctx context.Context, id string) (*biz.Question, e
 		Key:   "id",
 		Value: attribute.StringValue(id),
 	})
-	idObj, err := bson.ObjectIDFromHex(id)
+	idObj, err := parseID(id)
 	if err != nil {
-		r.log.Warn(err)
 		return nil, err
 	}
 	res := r.coll.FindOne(ctx, bson.M{"_id": idObj})
-	if res.Err() != nil {
-		r.log.Warn(res.Err())
-		return nil, res.Err()
-	}
-	if res.Err() != nil {
-		r.log.Warn(res.Err())
-		return nil, res.Err()
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorQuestionNotFound("question not found"))
 	}
 	var q Question
 	err = res.Decode(&q)
 	if err != nil {
 		r.log.Warn(err)
-		return nil, err
+		return nil, dbError(err)
 	}
 	return q.Biz(), nil
 }
@@ -117,78 +118,154 @@ func (r QuestionsRepo) GetByID(ctx context.Context, id string) (*biz.Question, e
 func (r QuestionsRepo) List(ctx context.Context, quizID string, pagination *biz.Pagination) ([]*biz.Question, error) {
 	ctx, span := r.tracer.Start(ctx, "data.QuestionsRepo.List")
 	defer span.End()
-	// error the provided hex string is not a valid ObjectID"
 
-	oid, err := bson.ObjectIDFromHex(quizID)
+	opts := options.Find().SetSkip(int64(pagination.Page * pagination.Size)).SetLimit(int64(pagination.Size))
+	filter := bson.M{"quiz_id": quizID}
+	return r.find(ctx, filter, opts)
+}
+
+func (r QuestionsRepo) ListByIDs(ctx context.Context, ids []string) ([]*biz.Question, error) {
+	ctx, span := r.tracer.Start(ctx, "data.QuestionsRepo.ListByIDs")
+	defer span.End()
+	span.SetAttributes(attribute.StringSlice("ids", ids))
+
+	oids := make([]bson.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		oid, err := parseID(id)
+		if err != nil {
+			return nil, err
+		}
+		oids = append(oids, oid)
+	}
+	return r.find(ctx, bson.M{"_id": bson.M{"$in": oids}})
+}
+
+func (r QuestionsRepo) Search(ctx context.Context, filter *biz.QuestionFilter, pagination *biz.Pagination) ([]*biz.Question, error) {
+	ctx, span := r.tracer.Start(ctx, "data.QuestionsRepo.Search")
+	defer span.End()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetSkip(int64(pagination.Page * pagination.Size)).
+		SetLimit(int64(pagination.Size))
+	return r.find(ctx, questionFilter(filter), opts)
+}
+
+func (r QuestionsRepo) MatchIDs(ctx context.Context, filter *biz.QuestionFilter) ([]string, error) {
+	ctx, span := r.tracer.Start(ctx, "data.QuestionsRepo.MatchIDs")
+	defer span.End()
+	span.SetAttributes(attribute.String("quiz_id", filter.QuizID), attribute.StringSlice("tags", filter.Tags))
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "order", Value: 1}, {Key: "_id", Value: 1}}).
+		SetProjection(bson.M{"_id": 1})
+	cur, err := r.coll.Find(ctx, questionFilter(filter), opts)
 	if err != nil {
 		r.log.Warn(err)
-		return nil, err
+		return nil, dbError(err)
 	}
-	opts := options.Find().SetSkip(int64(pagination.Page * pagination.Size)).SetLimit(int64(pagination.Size))
-	filter := bson.M{"quiz_id": oid}
-	cur, err := r.coll.Find(ctx, filter, opts)
+	var docs []struct {
+		ID bson.ObjectID `bson:"_id"`
+	}
+	if err := cur.All(ctx, &docs); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	ids := make([]string, 0, len(docs))
+	for _, d := range docs {
+		ids = append(ids, d.ID.Hex())
+	}
+	return ids, nil
+}
+
+func (r QuestionsRepo) find(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*biz.Question, error) {
+	cur, err := r.coll.Find(ctx, filter, opts...)
 	if err != nil {
 		r.log.Warn(err)
-		return nil, err
+		return nil, dbError(err)
 	}
 	var res []*biz.Question
 	for cur.Next(ctx) {
 		var q Question
 		if err := cur.Decode(&q); err != nil {
 			r.log.Warn(err)
-			return nil, err
+			return nil, dbError(err)
 		}
 		res = append(res, q.Biz())
 	}
 	return res, nil
 }
 
+func questionFilter(f *biz.QuestionFilter) bson.M {
+	filter := bson.M{"quiz_id": f.QuizID}
+	if len(f.Tags) > 0 {
+		filter["tags"] = bson.M{"$all": f.Tags}
+	}
+	if f.Difficulty != nil {
+		filter["difficulty"] = *f.Difficulty
+	}
+	return filter
+}
+
 func (r QuestionsRepo) Update(ctx context.Context, q *biz.Question) (*biz.Question, error) {
 	ctx, span := r.tracer.Start(ctx, "data.QuestionsRepo.Update")
 	defer span.End()
 
-	question := &Question{
-		Question:   q.Question,
-		Difficulty: &q.Difficulty,
-		Order:      q.Order,
-	}
-	idObj, err := bson.ObjectIDFromHex(q.ID)
+	question := QuestionToData(q)
+	idObj, err := parseID(q.ID)
 	if err != nil {
-		r.log.Warn(err)
 		return nil, err
 	}
-	res, err := r.coll.UpdateOne(ctx, bson.M{"_id": idObj}, bson.M{"$set": question})
-	if err != nil {
-		r.log.Warn(err)
-		return nil, err
+	set := bson.M{
+		"question":   question.Question,
+		"difficulty": question.Difficulty,
+		"order":      question.Order,
+		"answers":    question.Answers,
+		"hint":       question.Hint,
+		"tags":       question.Tags,
+		"media":      question.Media,
+		"format":     question.Format,
+		"updated_at": time.Now().String(),
+	}
+	if question.UpdatedBy != "" {
+		set["updated_by"] = question.UpdatedBy
+	}
+	if question.QuestionTranslations != nil {
+		set["question_translations"] = question.QuestionTranslations
 	}
-	if res.Acknowledged == false {
-		return nil, errors.InternalServer("failed to update quiz", "failed to update quiz")
+	if question.HintTranslations != nil {
+		set["hint_translations"] = question.HintTranslations
 	}
-	if res.ModifiedCount == 0 {
-		return nil, errors.NotFound("quiz not found", "quiz not found")
+	update := bson.M{"$set": set}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	res := r.coll.FindOneAndUpdate(ctx, bson.M{"_id": idObj}, update, opts)
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorQuestionNotFound("question not found"))
 	}
-	return question.Biz(), nil
+	var updated Question
+	if err := res.Decode(&updated); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	return updated.Biz(), nil
 }
 
 func (r QuestionsRepo) Delete(ctx context.Context, id string) (*biz.Question, error) {
 	ctx, span := r.tracer.Start(ctx, "data.QuestionsRepo.Delete")
 	defer span.End()
 
-	idObj, err := bson.ObjectIDFromHex(id)
+	idObj, err := parseID(id)
 	if err != nil {
-		r.log.Warn(err)
 		return nil, err
 	}
-	res, err := r.coll.DeleteOne(ctx, bson.M{"_id": idObj})
-	if err != nil {
-		r.log.Warn(err)
-		return nil, err
+	res := r.coll.FindOneAndDelete(ctx, bson.M{"_id": idObj})
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorQuestionNotFound("question not found"))
 	}
-	if res.DeletedCount == 0 {
-		return nil, errors.NotFound("quiz not found", "quiz not found")
+	var deleted Question
+	if err := res.Decode(&deleted); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
 	}
-	return &biz.Question{
-		ID: id,
-	}, nil
+	return deleted.Biz(), nil
 }
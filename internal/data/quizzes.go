@@ -2,7 +2,6 @@ package data
 
 import (
 	"context"
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/jinzhu/copier"
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -12,6 +11,8 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"quiz/internal/biz"
 	"time"
+
+	pb "quiz/api/quizzes/v1"
 )
 
 type Quiz struct {
@@ -25,12 +26,40 @@ type Quiz struct {
 	Category    *string           `bson:"category"`
 	Tags        []string          `bson:"tags"`
 	Metadata    map[string]string `bson:"metadata"`
-	CreatedBy   string            `bson:"created_by"`
-	UpdatedBy   string            `bson:"updated_by"`
-	DeletedBy   string            `bson:"deleted_by"`
-	CreatedAt   string            `bson:"created_at"`
-	UpdatedAt   string            `bson:"updated_at"`
-	DeletedAt   string            `bson:"deleted_at"`
+	Premium     *bool             `bson:"premium,omitempty"`
+	QuestionIDs []string          `bson:"question_ids,omitempty"`
+	DrawRules   []DrawRule        `bson:"draw_rules,omitempty"`
+	Shuffle     *ShuffleSettings  `bson:"shuffle,omitempty"`
+	Adaptive    *AdaptiveSettings `bson:"adaptive,omitempty"`
+	PublishedAt string            `bson:"published_at,omitempty"`
+
+	DefaultLocale           string            `bson:"default_locale,omitempty"`
+	Locales                 []string          `bson:"locales,omitempty"`
+	TitleTranslations       map[string]string `bson:"title_translations,omitempty"`
+	DescriptionTranslations map[string]string `bson:"description_translations,omitempty"`
+
+	CreatedBy string `bson:"created_by"`
+	UpdatedBy string `bson:"updated_by"`
+	DeletedBy string `bson:"deleted_by"`
+	CreatedAt string `bson:"created_at"`
+	UpdatedAt string `bson:"updated_at"`
+	DeletedAt string `bson:"deleted_at"`
+}
+
+type DrawRule struct {
+	Count      uint32   `bson:"count"`
+	Difficulty *uint64  `bson:"difficulty,omitempty"`
+	Tags       []string `bson:"tags,omitempty"`
+}
+
+type ShuffleSettings struct {
+	Questions bool `bson:"questions"`
+	Answers   bool `bson:"answers"`
+}
+
+type AdaptiveSettings struct {
+	Length          uint32  `bson:"length"`
+	StartDifficulty *uint64 `bson:"start_difficulty,omitempty"`
 }
 
 type QuizRepo struct {
@@ -40,7 +69,7 @@ type QuizRepo struct {
 	table  string
 }
 
-func NewQuizRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.QuizRepo {
+func NewQuizRepo(data *Data, logger log.Logger, tracer trace.Tracer) *QuizRepo {
 	return &QuizRepo{
 		coll:   data.mongo.Collection("quizzes"),
 		log:    log.NewHelper(logger),
@@ -58,8 +87,11 @@ func (r *QuizRepo) Save(ctx context.Context, q *biz.Quiz) (*biz.Quiz, error) {
 	err := copier.Copy(&quiz, q)
 	if err != nil {
 		r.log.Warn(err)
-		return nil, err
+		return nil, dbError(err)
 	}
+	quiz.DrawRules = DrawRulesToData(q.DrawRules)
+	quiz.Shuffle = ShuffleToData(q.Shuffle)
+	quiz.Adaptive = AdaptiveToData(q.Adaptive)
 	quiz.CreatedBy = q.UserID
 	quiz.CreatedAt = createdAt
 	quiz.UpdatedBy = q.UserID
@@ -68,16 +100,15 @@ func (r *QuizRepo) Save(ctx context.Context, q *biz.Quiz) (*biz.Quiz, error) {
 	res, err := r.coll.InsertOne(ctx, quiz)
 	if err != nil {
 		r.log.Warn(err)
-		return nil, err
+		return nil, dbError(err)
 	}
 
-	if oid, ok := res.InsertedID.(bson.ObjectID); ok {
-		hex := oid.Hex()
-		r.log.Debugf("inserted id is object id: %s", hex)
-		resQuiz := quiz.QuizToBiz()
-		return resQuiz, nil
+	oid, err := insertedID(res)
+	if err != nil {
+		return nil, err
 	}
-	return nil, errors.InternalServer("inserted id is not object id", "inserted id is not object id")
+	quiz.ID = oid
+	return quiz.QuizToBiz(), nil
 }
 
 func (r *QuizRepo) GetByID(ctx context.Context, id string) (*biz.Quiz, error) {
@@ -87,25 +118,19 @@ func (r *QuizRepo) GetByID(ctx context.Context, id string) (*biz.Quiz, error) {
 		Key:   "id",
 		Value: attribute.StringValue(id),
 	})
-	idObj, err := bson.ObjectIDFromHex(id)
+	idObj, err := parseID(id)
 	if err != nil {
-		r.log.Warn(err)
 		return nil, err
 	}
 	res := r.coll.FindOne(ctx, bson.M{"_id": idObj})
-	if res.Err() != nil {
-		r.log.Warn(res.Err())
-		return nil, res.Err()
-	}
-	if res.Err() != nil {
-		r.log.Warn(res.Err())
-		return nil, res.Err()
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorQuizNotFound("quiz not found"))
 	}
 	var q Quiz
 	err = res.Decode(&q)
 	if err != nil {
 		r.log.Warn(err)
-		return nil, err
+		return nil, dbError(err)
 	}
 	return q.QuizToBiz(), nil
 }
@@ -117,14 +142,14 @@ func (r *QuizRepo) List(ctx context.Context, pagination *biz.Pagination) ([]*biz
 	cur, err := r.coll.Find(ctx, bson.M{}, opts)
 	if err != nil {
 		r.log.Warn(err)
-		return nil, err
+		return nil, dbError(err)
 	}
 	var res []*biz.Quiz
 	for cur.Next(ctx) {
 		var q Quiz
 		if err := cur.Decode(&q); err != nil {
 			r.log.Warn(err)
-			return nil, err
+			return nil, dbError(err)
 		}
 		res = append(res, q.QuizToBiz())
 	}
@@ -135,32 +160,77 @@ func (r *QuizRepo) Update(ctx context.Context, q *biz.Quiz) (*biz.Quiz, error) {
 	ctx, span := r.tracer.Start(ctx, "data.QuizRepo.Update")
 	defer span.End()
 
-	quiz := &Quiz{
-		Title:       q.Title,
-		Description: q.Description,
-		Duration:    q.Duration,
-		Thumbnail:   q.Thumbnail,
-		Cover:       q.Cover,
-		Category:    q.Category,
-		Tags:        q.Tags,
-		Metadata:    q.Metadata,
-	}
-	idObj, err := bson.ObjectIDFromHex(q.ID)
+	idObj, err := parseID(q.ID)
 	if err != nil {
-		r.log.Warn(err)
 		return nil, err
 	}
-	quiz.UpdatedAt = time.Now().String()
-	res, err := r.coll.UpdateOne(ctx, bson.M{"_id": idObj}, bson.M{"$set": quiz})
+	// only the editable fields, so ownership and audit fields survive
+	set := bson.M{
+		"title":       q.Title,
+		"description": q.Description,
+		"duration":    q.Duration,
+		"thumbnail":   q.Thumbnail,
+		"cover":       q.Cover,
+		"category":    q.Category,
+		"tags":        q.Tags,
+		"metadata":    q.Metadata,
+		"updated_at":  time.Now().String(),
+	}
+	if q.UpdatedBy != "" {
+		set["updated_by"] = q.UpdatedBy
+	}
+	// settings left out of the request keep their stored value
+	if q.Premium != nil {
+		set["premium"] = q.Premium
+	}
+	if q.QuestionIDs != nil {
+		set["question_ids"] = q.QuestionIDs
+	}
+	if q.DrawRules != nil {
+		set["draw_rules"] = DrawRulesToData(q.DrawRules)
+	}
+	if q.Shuffle != nil {
+		set["shuffle"] = ShuffleToData(q.Shuffle)
+	}
+	if q.Adaptive != nil {
+		set["adaptive"] = AdaptiveToData(q.Adaptive)
+	}
+	if q.DefaultLocale != "" {
+		set["default_locale"] = q.DefaultLocale
+	}
+	if q.Locales != nil {
+		set["locales"] = q.Locales
+	}
+	if q.TitleTranslations != nil {
+		set["title_translations"] = map[string]string(q.TitleTranslations)
+	}
+	if q.DescriptionTranslations != nil {
+		set["description_translations"] = map[string]string(q.DescriptionTranslations)
+	}
+	return r.findOneAndUpdate(ctx, idObj, bson.M{"$set": set})
+}
+
+func (r *QuizRepo) Publish(ctx context.Context, id string, at string) (*biz.Quiz, error) {
+	ctx, span := r.tracer.Start(ctx, "data.QuizRepo.Publish", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
 	if err != nil {
-		r.log.Warn(err)
 		return nil, err
 	}
-	if res.Acknowledged == false {
-		return nil, errors.InternalServer("failed to update quiz", "failed to update quiz")
+	return r.findOneAndUpdate(ctx, idObj, bson.M{"$set": bson.M{"published_at": at}})
+}
+
+func (r *QuizRepo) findOneAndUpdate(ctx context.Context, id bson.ObjectID, update bson.M) (*biz.Quiz, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	res := r.coll.FindOneAndUpdate(ctx, bson.M{"_id": id}, update, opts)
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorQuizNotFound("quiz not found"))
 	}
-	if res.ModifiedCount == 0 {
-		return nil, errors.NotFound("quiz not found", "quiz not found")
+	var quiz Quiz
+	if err := res.Decode(&quiz); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
 	}
 	return quiz.QuizToBiz(), nil
 }
@@ -169,18 +239,17 @@ func (r *QuizRepo) Delete(ctx context.Context, id string) (*biz.Quiz, error) {
 	ctx, span := r.tracer.Start(ctx, "data.QuizRepo.Delete")
 	defer span.End()
 
-	idObj, err := bson.ObjectIDFromHex(id)
+	idObj, err := parseID(id)
 	if err != nil {
-		r.log.Warn(err)
 		return nil, err
 	}
 	res, err := r.coll.DeleteOne(ctx, bson.M{"_id": idObj})
 	if err != nil {
 		r.log.Warn(err)
-		return nil, err
+		return nil, dbError(err)
 	}
 	if res.DeletedCount == 0 {
-		return nil, errors.NotFound("quiz not found", "quiz not found")
+		return nil, pb.ErrorQuizNotFound("quiz not found")
 	}
 	return &biz.Quiz{
 		ID: id,
@@ -190,5 +259,5 @@ func (r *QuizRepo) Search(ctx context.Context, keyword string, pagination *biz.P
 	ctx, span := r.tracer.Start(ctx, "data.QuizRepo.Search")
 	defer span.End()
 
-	return nil, errors.InternalServer("not implemented", "not implemented")
+	return nil, pb.ErrorNotImplemented("quiz search is not implemented")
 }
@@ -0,0 +1,111 @@
+package data
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.opentelemetry.io/otel/trace/noop"
+	"quiz/internal/biz"
+	"quiz/internal/conf"
+	"quiz/internal/dep"
+)
+
+// testData connects to the Mongo server at QUIZ_TEST_MONGO_URI, in a database
+// dropped after the test, or skips the test when the variable is unset.
+func testData(t *testing.T) *Data {
+	t.Helper()
+	uri := os.Getenv("QUIZ_TEST_MONGO_URI")
+	if uri == "" {
+		t.Skip("QUIZ_TEST_MONGO_URI is not set")
+	}
+	c := &conf.Data{Mongo: &conf.Data_Mongo{Uri: uri, Database: "quiz_test_" + bson.NewObjectID().Hex()}}
+	m, cleanup, err := dep.NewMongo(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = m.DB.Drop(context.Background())
+		cleanup()
+	})
+	return &Data{mongo: m.DB, logger: log.DefaultLogger}
+}
+
+// directTx runs fn as is, for tests that do not exercise transactions.
+type directTx struct{}
+
+func (directTx) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestQuizRepoSaveReturnsID(t *testing.T) {
+	data := testData(t)
+	tracer := noop.NewTracerProvider().Tracer("")
+	repo := NewQuizRepo(data, log.DefaultLogger, tracer)
+
+	saved, err := repo.Save(context.Background(), &biz.Quiz{UserID: "u1", Title: "Capitals"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.ID == "" {
+		t.Fatal("saved quiz has no ID")
+	}
+	got, err := repo.GetByID(context.Background(), saved.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "Capitals" {
+		t.Errorf("title = %q, want %q", got.Title, "Capitals")
+	}
+}
+
+func TestCloneQuizMovesQuestionsToClone(t *testing.T) {
+	data := testData(t)
+	tracer := noop.NewTracerProvider().Tracer("")
+	logger := log.DefaultLogger
+	quizzes := NewQuizRepo(data, logger, tracer)
+	questions := NewQuestionsRepo(data, logger, tracer)
+	collaborators := NewCollaboratorsRepo(data, logger, tracer)
+	gate := biz.NewPremiumGate(nil, nil, collaborators, logger, tracer)
+	uc := biz.NewQuizUsecase(quizzes, questions, collaborators, gate, directTx{}, NewOutboxRepo(data, logger, tracer), NewAuditRepo(data, logger, tracer), logger, tracer)
+
+	ctx := biz.WithActor(context.Background(), "u1")
+	source, err := quizzes.Save(ctx, &biz.Quiz{UserID: "u1", Title: "Capitals"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, text := range []string{"Capital of France?", "Capital of Peru?"} {
+		if _, err := questions.Save(ctx, &biz.Question{QuizID: source.ID, Question: text}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	clone, copied, err := uc.CloneQuiz(ctx, source.ID, &biz.CloneOverrides{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clone.ID == "" || clone.ID == source.ID {
+		t.Fatalf("clone ID = %q, source ID = %q", clone.ID, source.ID)
+	}
+	if copied != 2 {
+		t.Errorf("copied = %d, want 2", copied)
+	}
+	ids, err := questions.MatchIDs(ctx, &biz.QuestionFilter{QuizID: clone.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 {
+		t.Errorf("clone has %d questions, want 2", len(ids))
+	}
+	cloned, err := questions.ListByIDs(ctx, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, q := range cloned {
+		if q.QuizID != clone.ID {
+			t.Errorf("question %s has quiz_id %q, want %q", q.ID, q.QuizID, clone.ID)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package data
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"quiz/internal/biz"
+	"quiz/internal/conf"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	RateLimitStoreMemory = "memory"
+	RateLimitStoreRedis  = "redis"
+
+	rateLimitSweepInterval = time.Minute
+)
+
+// NewRateLimitStore returns the store configured under rate_limit.store. The
+// memory store only sees the requests of its own instance; deployments with
+// several of them share buckets through Redis.
+func NewRateLimitStore(bc *conf.Bootstrap, data *Data, logger log.Logger) (biz.RateLimitStore, error) {
+	switch bc.GetRateLimit().GetStore() {
+	case RateLimitStoreRedis:
+		if data.redis == nil {
+			return nil, pb.ErrorInternal("the redis rate limit store needs data.redis")
+		}
+		return &redisBuckets{rdb: data.redis}, nil
+	default:
+		return &memoryBuckets{buckets: make(map[string]*bucket), lastSweep: time.Now()}, nil
+	}
+}
+
+type bucket struct {
+	limit  biz.Limit
+	tokens float64
+	at     time.Time
+}
+
+// refill adds the tokens earned since the bucket was last touched.
+func (b *bucket) refill(now time.Time) {
+	b.tokens = math.Min(float64(b.limit.Burst), b.tokens+now.Sub(b.at).Seconds()*b.limit.Rate)
+	b.at = now
+}
+
+type memoryBuckets struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func (s *memoryBuckets) Take(_ context.Context, key string, limit biz.Limit) (bool, time.Duration, error) {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), at: now}
+		s.buckets[key] = b
+	}
+	b.limit = limit
+	b.refill(now)
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second)), nil
+	}
+	b.tokens--
+	return true, 0, nil
+}
+
+// sweep drops the buckets that have filled up again, since a full bucket is
+// the same as none. It is called with mu held.
+func (s *memoryBuckets) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if b.refill(now); b.tokens >= float64(b.limit.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// takeScript refills and takes from the bucket in one round trip, so that
+// instances racing on a key cannot both spend the last token. The bucket
+// expires once it would be full again.
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil then
+  tokens = burst
+  ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) / 1000 * rate)
+local ok = 0
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  ok = 1
+else
+  wait = math.ceil((1 - tokens) / rate * 1000)
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil((burst - tokens) / rate * 1000) + 1000)
+return {ok, wait}
+`)
+
+type redisBuckets struct {
+	rdb *redis.Client
+}
+
+func (s *redisBuckets) Take(ctx context.Context, key string, limit biz.Limit) (bool, time.Duration, error) {
+	res, err := takeScript.Run(ctx, s.rdb, []string{"ratelimit:" + key}, limit.Rate, limit.Burst, time.Now().UnixMilli()).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
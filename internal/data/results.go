@@ -0,0 +1,244 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+)
+
+// QuizStats is the output of the stats pipelines, grouped by quiz or by user.
+type QuizStats struct {
+	QuizID        string    `bson:"quiz_id"`
+	UserID        string    `bson:"user_id"`
+	Attempts      int64     `bson:"attempts"`
+	BestScore     float32   `bson:"best_score"`
+	LatestScore   float32   `bson:"latest_score"`
+	AverageScore  float32   `bson:"average_score"`
+	TimeSpentMs   int64     `bson:"time_spent_ms"`
+	LastAttemptAt time.Time `bson:"last_attempt_at"`
+}
+
+// ResultsRepo reports on the attempts collection.
+type ResultsRepo struct {
+	coll   *mongo.Collection
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewResultsRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.ResultsRepo {
+	r := &ResultsRepo{
+		coll:   data.mongo.Collection("attempts"),
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+	r.ensureIndexes()
+	return r
+}
+
+func (r *ResultsRepo) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "quiz_id", Value: 1}, {Key: "started_at", Value: -1}}},
+		{Keys: bson.D{{Key: "quiz_id", Value: 1}, {Key: "user_id", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Warnf("failed to create attempts indexes: %v", err)
+	}
+}
+
+func (r *ResultsRepo) ListAttempts(ctx context.Context, userID string, quizID string, pagination *biz.Pagination) ([]*biz.Attempt, error) {
+	ctx, span := r.tracer.Start(ctx, "data.ResultsRepo.ListAttempts", trace.WithAttributes(attribute.String("user_id", userID)))
+	defer span.End()
+
+	match := bson.M{"user_id": userID}
+	if quizID != "" {
+		match["quiz_id"] = quizID
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "started_at", Value: -1}}}},
+		{{Key: "$skip", Value: int64(pagination.Page * pagination.Size)}},
+		{{Key: "$limit", Value: int64(pagination.Size)}},
+		{{Key: "$project", Value: bson.M{"responses": 0, "pool": 0, "answer_orders": 0}}},
+	}
+	cur, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var attempts []Attempt
+	if err := cur.All(ctx, &attempts); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.Attempt, 0, len(attempts))
+	for _, a := range attempts {
+		res = append(res, a.Biz())
+	}
+	return res, nil
+}
+
+func (r *ResultsRepo) StatsByQuiz(ctx context.Context, userID string, pagination *biz.Pagination) ([]*biz.QuizStats, error) {
+	ctx, span := r.tracer.Start(ctx, "data.ResultsRepo.StatsByQuiz", trace.WithAttributes(attribute.String("user_id", userID)))
+	defer span.End()
+
+	return r.stats(ctx, bson.M{"user_id": userID}, "quiz_id", bson.D{{Key: "last_attempt_at", Value: -1}}, pagination)
+}
+
+func (r *ResultsRepo) StatsByUser(ctx context.Context, quizID string, pagination *biz.Pagination) ([]*biz.QuizStats, error) {
+	ctx, span := r.tracer.Start(ctx, "data.ResultsRepo.StatsByUser", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	return r.stats(ctx, bson.M{"quiz_id": quizID}, "user_id", bson.D{{Key: "best_score", Value: -1}, {Key: "last_attempt_at", Value: -1}}, pagination)
+}
+
+// stats groups the submitted attempts matching match by one of quiz_id or
+// user_id. Attempts are sorted by submission first so $last is the latest.
+func (r *ResultsRepo) stats(ctx context.Context, match bson.M, groupBy string, sort bson.D, pagination *biz.Pagination) ([]*biz.QuizStats, error) {
+	match["submitted_at"] = bson.M{"$ne": nil}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "submitted_at", Value: 1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":             "$" + groupBy,
+			"quiz_id":         bson.M{"$first": "$quiz_id"},
+			"user_id":         bson.M{"$first": "$user_id"},
+			"attempts":        bson.M{"$sum": 1},
+			"best_score":      bson.M{"$max": "$score"},
+			"latest_score":    bson.M{"$last": "$score"},
+			"average_score":   bson.M{"$avg": "$score"},
+			"time_spent_ms":   bson.M{"$sum": bson.M{"$subtract": bson.A{"$submitted_at", "$started_at"}}},
+			"last_attempt_at": bson.M{"$last": "$submitted_at"},
+		}}},
+		{{Key: "$sort", Value: sort}},
+		{{Key: "$skip", Value: int64(pagination.Page * pagination.Size)}},
+		{{Key: "$limit", Value: int64(pagination.Size)}},
+	}
+	cur, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var stats []QuizStats
+	if err := cur.All(ctx, &stats); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.QuizStats, 0, len(stats))
+	for _, s := range stats {
+		res = append(res, s.Biz())
+	}
+	return res, nil
+}
+
+// ItemStats runs one pass over the submitted attempts of a quiz, collecting
+// the score spread, the per-question sums and the per-answer choice counts.
+func (r *ResultsRepo) ItemStats(ctx context.Context, quizID string) (*biz.QuizItemStats, error) {
+	ctx, span := r.tracer.Start(ctx, "data.ResultsRepo.ItemStats", trace.WithAttributes(attribute.String("quiz_id", quizID)))
+	defer span.End()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"quiz_id": quizID, "submitted_at": bson.M{"$ne": nil}}}},
+		{{Key: "$facet", Value: bson.M{
+			"overall": bson.A{
+				bson.M{"$group": bson.M{
+					"_id":       nil,
+					"attempts":  bson.M{"$sum": 1},
+					"score_std": bson.M{"$stdDevPop": "$score"},
+				}},
+			},
+			"items": bson.A{
+				bson.M{"$unwind": "$responses"},
+				bson.M{"$group": bson.M{
+					"_id":             "$responses.question_id",
+					"responses":       bson.M{"$sum": 1},
+					"correct":         bson.M{"$sum": bson.M{"$cond": bson.A{"$responses.correct", 1, 0}}},
+					"score_correct":   bson.M{"$sum": bson.M{"$cond": bson.A{"$responses.correct", "$score", 0}}},
+					"score_incorrect": bson.M{"$sum": bson.M{"$cond": bson.A{"$responses.correct", 0, "$score"}}},
+					"avg_time_ms":     bson.M{"$avg": "$responses.time_spent_ms"},
+				}},
+				bson.M{"$sort": bson.M{"_id": 1}},
+			},
+			"choices": bson.A{
+				bson.M{"$unwind": "$responses"},
+				bson.M{"$unwind": "$responses.answers"},
+				bson.M{"$match": bson.M{"responses.answers.checked": true}},
+				bson.M{"$group": bson.M{
+					"_id": bson.M{
+						"question_id": "$responses.question_id",
+						"answer_id":   "$responses.answers.answer_id",
+					},
+					"chosen": bson.M{"$sum": 1},
+				}},
+			},
+		}}},
+	}
+	cur, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var facets []struct {
+		Overall []struct {
+			Attempts int64   `bson:"attempts"`
+			ScoreStd float64 `bson:"score_std"`
+		} `bson:"overall"`
+		Items []struct {
+			QuestionID     string  `bson:"_id"`
+			Responses      int64   `bson:"responses"`
+			Correct        int64   `bson:"correct"`
+			ScoreCorrect   float64 `bson:"score_correct"`
+			ScoreIncorrect float64 `bson:"score_incorrect"`
+			AvgTimeMs      float64 `bson:"avg_time_ms"`
+		} `bson:"items"`
+		Choices []struct {
+			ID struct {
+				QuestionID string `bson:"question_id"`
+				AnswerID   string `bson:"answer_id"`
+			} `bson:"_id"`
+			Chosen int64 `bson:"chosen"`
+		} `bson:"choices"`
+	}
+	if err := cur.All(ctx, &facets); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+
+	res := &biz.QuizItemStats{}
+	if len(facets) == 0 {
+		return res, nil
+	}
+	f := facets[0]
+	if len(f.Overall) > 0 {
+		res.Attempts = f.Overall[0].Attempts
+		res.ScoreStdDev = f.Overall[0].ScoreStd
+	}
+	items := make(map[string]*biz.ItemStats, len(f.Items))
+	for _, i := range f.Items {
+		item := &biz.ItemStats{
+			QuestionID:     i.QuestionID,
+			Responses:      i.Responses,
+			Correct:        i.Correct,
+			ScoreCorrect:   i.ScoreCorrect,
+			ScoreIncorrect: i.ScoreIncorrect,
+			AverageTime:    time.Duration(i.AvgTimeMs * float64(time.Millisecond)),
+			Chosen:         make(map[string]int64),
+		}
+		items[i.QuestionID] = item
+		res.Items = append(res.Items, item)
+	}
+	for _, c := range f.Choices {
+		if item, ok := items[c.ID.QuestionID]; ok {
+			item.Chosen[c.ID.AnswerID] = c.Chosen
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,152 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"quiz/internal/biz"
+)
+
+// roomBuffer is how many messages a slow subscriber may fall behind before
+// messages to it are dropped.
+const roomBuffer = 256
+
+// NewRoomBroker returns a broker over Redis pub/sub when Redis is configured,
+// so clients can reach rooms owned by other instances, and an in-process hub
+// otherwise.
+func NewRoomBroker(data *Data, logger log.Logger) biz.RoomBroker {
+	if data.redis != nil {
+		return &redisRoomBroker{rdb: data.redis, log: log.NewHelper(logger)}
+	}
+	return &localRoomBroker{
+		pins: make(map[string]time.Time),
+		subs: make(map[string]map[chan []byte]struct{}),
+		log:  log.NewHelper(logger),
+	}
+}
+
+type localRoomBroker struct {
+	mu   sync.Mutex
+	pins map[string]time.Time
+	subs map[string]map[chan []byte]struct{}
+	log  *log.Helper
+}
+
+func (b *localRoomBroker) Reserve(_ context.Context, pin string, ttl time.Duration) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if expiresAt, ok := b.pins[pin]; ok && time.Now().Before(expiresAt) {
+		return false, nil
+	}
+	b.pins[pin] = time.Now().Add(ttl)
+	return true, nil
+}
+
+func (b *localRoomBroker) Release(_ context.Context, pin string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.pins, pin)
+	return nil
+}
+
+func (b *localRoomBroker) Exists(_ context.Context, pin string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	expiresAt, ok := b.pins[pin]
+	return ok && time.Now().Before(expiresAt), nil
+}
+
+func (b *localRoomBroker) Publish(_ context.Context, topic string, msg []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs[topic] {
+		select {
+		case ch <- msg:
+		default:
+			b.log.Warnf("dropping message on %s for a slow subscriber", topic)
+		}
+	}
+	return nil
+}
+
+func (b *localRoomBroker) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	ch := make(chan []byte, roomBuffer)
+	b.mu.Lock()
+	if b.subs[topic] == nil {
+		b.subs[topic] = make(map[chan []byte]struct{})
+	}
+	b.subs[topic][ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subs[topic], ch)
+		if len(b.subs[topic]) == 0 {
+			delete(b.subs, topic)
+		}
+		close(ch)
+	}()
+	return ch, nil
+}
+
+type redisRoomBroker struct {
+	rdb *redis.Client
+	log *log.Helper
+}
+
+func roomKey(pin string) string {
+	return "room:" + pin
+}
+
+func (b *redisRoomBroker) Reserve(ctx context.Context, pin string, ttl time.Duration) (bool, error) {
+	return b.rdb.SetNX(ctx, roomKey(pin), 1, ttl).Result()
+}
+
+func (b *redisRoomBroker) Release(ctx context.Context, pin string) error {
+	return b.rdb.Del(ctx, roomKey(pin)).Err()
+}
+
+func (b *redisRoomBroker) Exists(ctx context.Context, pin string) (bool, error) {
+	n, err := b.rdb.Exists(ctx, roomKey(pin)).Result()
+	return n > 0, err
+}
+
+func (b *redisRoomBroker) Publish(ctx context.Context, topic string, msg []byte) error {
+	return b.rdb.Publish(ctx, topic, msg).Err()
+}
+
+func (b *redisRoomBroker) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	sub := b.rdb.Subscribe(ctx, topic)
+	// wait for the confirmation so nothing published after we return is missed
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, err
+	}
+	ch := make(chan []byte, roomBuffer)
+	go func() {
+		defer close(ch)
+		defer sub.Close()
+		msgs := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case m, ok := <-msgs:
+				if !ok {
+					return
+				}
+				select {
+				case ch <- []byte(m.Payload):
+				default:
+					b.log.Warnf("dropping message on %s for a slow subscriber", topic)
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
@@ -0,0 +1,90 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nats-io/nats.go"
+	"quiz/internal/biz"
+	"quiz/internal/conf"
+	"quiz/internal/dep"
+)
+
+const (
+	EventSinkFile = "file"
+	EventSinkNats = "nats"
+
+	defaultSubjectPrefix = "quizzes"
+)
+
+// NewEventSink returns the sink configured under events.sink.
+func NewEventSink(bc *conf.Bootstrap, logger log.Logger) (biz.EventSink, func(), error) {
+	c := bc.GetEvents()
+	switch c.GetSink() {
+	case EventSinkNats:
+		conn, cleanup, err := dep.NewNats(c.GetNats(), logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		prefix := c.GetNats().GetSubjectPrefix()
+		if prefix == "" {
+			prefix = defaultSubjectPrefix
+		}
+		return &natsSink{conn: conn, prefix: prefix}, cleanup, nil
+	default:
+		if path := c.GetFile().GetPath(); path != "" {
+			f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &fileSink{w: f}, func() { _ = f.Close() }, nil
+		}
+		return &fileSink{w: os.Stdout}, func() {}, nil
+	}
+}
+
+// fileSink writes one JSON event per line.
+type fileSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *fileSink) Publish(_ context.Context, events []*biz.DomainEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	enc := json.NewEncoder(s.w)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// natsSink publishes each event to <prefix>.<type>, with the event ID as
+// Nats-Msg-Id so JetStream streams drop redelivered events.
+type natsSink struct {
+	conn   *nats.Conn
+	prefix string
+}
+
+func (s *natsSink) Publish(ctx context.Context, events []*biz.DomainEvent) error {
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		msg := nats.NewMsg(s.prefix + "." + e.Type)
+		msg.Header.Set(nats.MsgIdHdr, e.ID)
+		msg.Data = data
+		if err := s.conn.PublishMsg(msg); err != nil {
+			return err
+		}
+	}
+	// make sure the server has the batch before it is marked published
+	return s.conn.FlushWithContext(ctx)
+}
@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"quiz/internal/biz"
+	"quiz/internal/conf"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type Transaction struct {
+	client *mongo.Client
+	// set when the server cannot run transactions and data.mongo allows it
+	standalone bool
+	log        *log.Helper
+}
+
+// NewTransaction refuses a standalone Mongo server, on which changes and their
+// outbox events could not be written atomically, unless
+// data.mongo.allow_standalone says otherwise.
+func NewTransaction(c *conf.Data, data *Data, logger log.Logger) (biz.Transaction, error) {
+	t := &Transaction{
+		client: data.mongo.Client(),
+		log:    log.NewHelper(logger),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := t.client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return nil, err
+	}
+	// replica set members name their set and mongos routers say so
+	if hello.SetName != "" || hello.Msg == "isdbgrid" {
+		return t, nil
+	}
+	if !c.GetMongo().GetAllowStandalone() {
+		return nil, pb.ErrorInternal("mongo is a standalone server, which cannot run transactions; use a replica set or set data.mongo.allow_standalone for local development")
+	}
+	t.log.Error("mongo is a standalone server: writing WITHOUT transactions, changes may land without their outbox events and audit entries")
+	t.standalone = true
+	return t, nil
+}
+
+type afterCommitKey struct{}
+
+// afterCommit runs fn once the transaction in ctx commits, or right away
+// outside of one. Hooks of a transaction that aborts are dropped.
+func afterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(afterCommitKey{}).(*[]func()); ok {
+		*hooks = append(*hooks, fn)
+		return
+	}
+	fn()
+}
+
+// inTx reports whether ctx belongs to a transaction run by InTx.
+func inTx(ctx context.Context) bool {
+	_, ok := ctx.Value(afterCommitKey{}).(*[]func())
+	return ok
+}
+
+// InTx runs fn in a Mongo transaction, or without one on a standalone server
+// when allowed, then runs the hooks fn registered with afterCommit.
+func (t *Transaction) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	var hooks []func()
+	if t.standalone {
+		err := fn(context.WithValue(ctx, afterCommitKey{}, &hooks))
+		// without a transaction the writes before a failure have landed too
+		runHooks(hooks)
+		return err
+	}
+	sess, err := t.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer sess.EndSession(ctx)
+	_, err = sess.WithTransaction(ctx, func(ctx context.Context) (any, error) {
+		// a retried transaction registers its hooks anew
+		hooks = hooks[:0]
+		return nil, fn(context.WithValue(ctx, afterCommitKey{}, &hooks))
+	})
+	if err != nil {
+		return err
+	}
+	runHooks(hooks)
+	return nil
+}
+
+func runHooks(hooks []func()) {
+	for _, hook := range hooks {
+		hook()
+	}
+}
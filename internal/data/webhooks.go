@@ -0,0 +1,378 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type Webhook struct {
+	ID        bson.ObjectID `bson:"_id,omitempty"`
+	OwnerID   string        `bson:"owner_id"`
+	URL       string        `bson:"url"`
+	Events    []string      `bson:"events"`
+	Secret    string        `bson:"secret"`
+	CreatedAt time.Time     `bson:"created_at"`
+}
+
+type WebhookDelivery struct {
+	ID             bson.ObjectID `bson:"_id,omitempty"`
+	WebhookID      string        `bson:"webhook_id"`
+	OwnerID        string        `bson:"owner_id"`
+	EventID        string        `bson:"event_id"`
+	EventType      string        `bson:"event_type"`
+	Payload        string        `bson:"payload"`
+	Status         int32         `bson:"status"`
+	Attempts       uint32        `bson:"attempts"`
+	LastStatusCode int           `bson:"last_status_code,omitempty"`
+	LastError      string        `bson:"last_error,omitempty"`
+	NextAttemptAt  *time.Time    `bson:"next_attempt_at"`
+	CreatedAt      time.Time     `bson:"created_at"`
+	DeliveredAt    *time.Time    `bson:"delivered_at"`
+}
+
+type WebhooksRepo struct {
+	coll   *mongo.Collection
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewWebhooksRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.WebhooksRepo {
+	r := &WebhooksRepo{
+		coll:   data.mongo.Collection("webhooks"),
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+	r.ensureIndexes()
+	return r
+}
+
+func (r *WebhooksRepo) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "owner_id", Value: 1}, {Key: "events", Value: 1}},
+	})
+	if err != nil {
+		r.log.Warnf("failed to create webhook indexes: %v", err)
+	}
+}
+
+func (r *WebhooksRepo) Save(ctx context.Context, w *biz.Webhook) (*biz.Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "data.WebhooksRepo.Save")
+	defer span.End()
+
+	webhook := WebhookToData(w)
+	res, err := r.coll.InsertOne(ctx, webhook)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	oid, err := insertedID(res)
+	if err != nil {
+		return nil, err
+	}
+	webhook.ID = oid
+	return webhook.Biz(), nil
+}
+
+func (r *WebhooksRepo) GetByID(ctx context.Context, id string) (*biz.Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "data.WebhooksRepo.GetByID", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	res := r.coll.FindOne(ctx, bson.M{"_id": idObj})
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorWebhookNotFound("webhook not found"))
+	}
+	var w Webhook
+	if err := res.Decode(&w); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	return w.Biz(), nil
+}
+
+func (r *WebhooksRepo) List(ctx context.Context, ownerID string) ([]*biz.Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "data.WebhooksRepo.List", trace.WithAttributes(attribute.String("owner_id", ownerID)))
+	defer span.End()
+
+	return r.find(ctx, bson.M{"owner_id": ownerID})
+}
+
+func (r *WebhooksRepo) Subscribed(ctx context.Context, ownerID string, eventType string) ([]*biz.Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "data.WebhooksRepo.Subscribed", trace.WithAttributes(attribute.String("owner_id", ownerID), attribute.String("event", eventType)))
+	defer span.End()
+
+	return r.find(ctx, bson.M{"owner_id": ownerID, "events": eventType})
+}
+
+func (r *WebhooksRepo) find(ctx context.Context, filter bson.M) ([]*biz.Webhook, error) {
+	cur, err := r.coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var webhooks []Webhook
+	if err := cur.All(ctx, &webhooks); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		res = append(res, w.Biz())
+	}
+	return res, nil
+}
+
+func (r *WebhooksRepo) Delete(ctx context.Context, id string) error {
+	ctx, span := r.tracer.Start(ctx, "data.WebhooksRepo.Delete", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	res, err := r.coll.DeleteOne(ctx, bson.M{"_id": idObj})
+	if err != nil {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	if res.DeletedCount == 0 {
+		return pb.ErrorWebhookNotFound("webhook not found")
+	}
+	return nil
+}
+
+type WebhookDeliveriesRepo struct {
+	coll   *mongo.Collection
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewWebhookDeliveriesRepo(data *Data, logger log.Logger, tracer trace.Tracer) biz.WebhookDeliveriesRepo {
+	r := &WebhookDeliveriesRepo{
+		coll:   data.mongo.Collection("webhook_deliveries"),
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+	r.ensureIndexes()
+	return r
+}
+
+func (r *WebhookDeliveriesRepo) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "webhook_id", Value: 1}, {Key: "event_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}}},
+		{Keys: bson.D{{Key: "webhook_id", Value: 1}, {Key: "_id", Value: -1}}},
+	})
+	if err != nil {
+		r.log.Warnf("failed to create webhook delivery indexes: %v", err)
+	}
+}
+
+func (r *WebhookDeliveriesRepo) Enqueue(ctx context.Context, deliveries []*biz.WebhookDelivery) error {
+	ctx, span := r.tracer.Start(ctx, "data.WebhookDeliveriesRepo.Enqueue", trace.WithAttributes(attribute.Int("deliveries", len(deliveries))))
+	defer span.End()
+
+	docs := make([]any, 0, len(deliveries))
+	for _, d := range deliveries {
+		docs = append(docs, WebhookDeliveryToData(d))
+	}
+	// unordered so the deliveries already queued by an earlier try of the
+	// same batch do not stop the rest
+	_, err := r.coll.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		r.log.Warn(err)
+		return dbError(err)
+	}
+	return nil
+}
+
+func (r *WebhookDeliveriesRepo) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*biz.WebhookDelivery, error) {
+	ctx, span := r.tracer.Start(ctx, "data.WebhookDeliveriesRepo.Claim")
+	defer span.End()
+
+	filter := bson.M{
+		"status":          bson.M{"$in": bson.A{int32(biz.WebhookPending), int32(biz.WebhookRetrying)}},
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{"next_attempt_at": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).
+		SetReturnDocument(options.After)
+	var res []*biz.WebhookDelivery
+	for len(res) < limit {
+		var d WebhookDelivery
+		err := r.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&d)
+		if stderrors.Is(err, mongo.ErrNoDocuments) {
+			break
+		}
+		if err != nil {
+			r.log.Warn(err)
+			return res, dbError(err)
+		}
+		res = append(res, d.Biz())
+	}
+	span.SetAttributes(attribute.Int("deliveries", len(res)))
+	return res, nil
+}
+
+func (r *WebhookDeliveriesRepo) Update(ctx context.Context, d *biz.WebhookDelivery) (*biz.WebhookDelivery, error) {
+	ctx, span := r.tracer.Start(ctx, "data.WebhookDeliveriesRepo.Update", trace.WithAttributes(attribute.String("id", d.ID)))
+	defer span.End()
+
+	idObj, err := parseID(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	doc := WebhookDeliveryToData(d)
+	update := bson.M{"$set": bson.M{
+		"status":           doc.Status,
+		"attempts":         doc.Attempts,
+		"last_status_code": doc.LastStatusCode,
+		"last_error":       doc.LastError,
+		"next_attempt_at":  doc.NextAttemptAt,
+		"delivered_at":     doc.DeliveredAt,
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	return r.decode(r.coll.FindOneAndUpdate(ctx, bson.M{"_id": idObj}, update, opts))
+}
+
+func (r *WebhookDeliveriesRepo) GetByID(ctx context.Context, id string) (*biz.WebhookDelivery, error) {
+	ctx, span := r.tracer.Start(ctx, "data.WebhookDeliveriesRepo.GetByID", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	idObj, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.decode(r.coll.FindOne(ctx, bson.M{"_id": idObj}))
+}
+
+func (r *WebhookDeliveriesRepo) List(ctx context.Context, webhookID string, status *biz.WebhookStatus, pagination *biz.Pagination) ([]*biz.WebhookDelivery, error) {
+	ctx, span := r.tracer.Start(ctx, "data.WebhookDeliveriesRepo.List", trace.WithAttributes(attribute.String("webhook_id", webhookID)))
+	defer span.End()
+
+	filter := bson.M{"webhook_id": webhookID}
+	if status != nil {
+		filter["status"] = int32(*status)
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetSkip(int64(pagination.Page * pagination.Size)).
+		SetLimit(int64(pagination.Size))
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	var deliveries []WebhookDelivery
+	if err := cur.All(ctx, &deliveries); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	res := make([]*biz.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		res = append(res, d.Biz())
+	}
+	return res, nil
+}
+
+func (r *WebhookDeliveriesRepo) decode(res *mongo.SingleResult) (*biz.WebhookDelivery, error) {
+	if err := res.Err(); err != nil {
+		return nil, findError(err, pb.ErrorDeliveryNotFound("delivery not found"))
+	}
+	var d WebhookDelivery
+	if err := res.Decode(&d); err != nil {
+		r.log.Warn(err)
+		return nil, dbError(err)
+	}
+	return d.Biz(), nil
+}
+
+// maxWebhookResponse caps how much of a response body is read before the
+// connection is reused.
+const maxWebhookResponse = 64 << 10
+
+type httpWebhookSender struct {
+	client *http.Client
+}
+
+// NewWebhookSender returns a sender that POSTs over HTTPS. The per-request
+// timeout comes from the context the dispatcher passes in. Subscribers pick
+// the URL, so connections only go to public addresses, checked after DNS
+// resolution so that a name cannot be rebound to an internal one, and
+// redirects are not followed.
+func NewWebhookSender() biz.WebhookSender {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			addr, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !biz.PublicAddr(addr.Addr()) {
+				return fmt.Errorf("webhook address %s is not public", addr.Addr())
+			}
+			return nil
+		},
+	}
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		ForceAttemptHTTP2:   true,
+		TLSHandshakeTimeout: 10 * time.Second,
+		MaxIdleConnsPerHost: 4,
+	}
+	return &httpWebhookSender{client: &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+func (s *httpWebhookSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, dbError(err)
+	}
+	// webhooks stored before https was required are refused here
+	if req.URL.Scheme != "https" {
+		return 0, pb.ErrorInvalidArgument("webhook url must use https")
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, dbError(err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxWebhookResponse))
+	return resp.StatusCode, nil
+}
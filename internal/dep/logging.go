@@ -11,7 +11,7 @@ import (
 )
 
 func NewLogger(c *conf.Bootstrap) log.Logger {
-	switch strings.ToUpper(c.Log.Logger) {
+	switch strings.ToUpper(c.GetLog().GetLogger()) {
 	case conf.Log_ZAP.String():
 		level := zap.NewAtomicLevelAt(zap.DebugLevel)
 		return NewZapLogger(zapcore.EncoderConfig{
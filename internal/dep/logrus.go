@@ -36,7 +36,7 @@ func NewLogrusLogger(c *conf.Bootstrap) *LogrusLogger {
 		DisableTimestamp: false,
 	}
 
-	switch c.Metadata.Env {
+	switch c.GetMetadata().GetEnv() {
 	case 0, 1:
 		formatter = txtFmt
 	case 2, 3:
@@ -46,8 +46,8 @@ func NewLogrusLogger(c *conf.Bootstrap) *LogrusLogger {
 	}
 	l := logrus.New()
 	l.SetFormatter(formatter)
-	if c.Log.Filepath != "" {
-		file, err := os.OpenFile(c.Log.Filepath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if c.GetLog().GetFilepath() != "" {
+		file, err := os.OpenFile(c.GetLog().GetFilepath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 		if err == nil {
 			l.SetOutput(file)
 		} else {
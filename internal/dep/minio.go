@@ -0,0 +1,40 @@
+package dep
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"quiz/internal/conf"
+)
+
+// NewMinio connects to an S3-compatible store and creates the bucket when it
+// does not exist yet.
+func NewMinio(c *conf.Media_S3, logger log.Logger) (*minio.Client, error) {
+	lg := log.NewHelper(logger)
+	lg.Debug("Initiating NewMinio")
+	client, err := minio.New(c.GetEndpoint(), &minio.Options{
+		Creds:  credentials.NewStaticV4(c.GetAccessKey(), c.GetSecretKey(), ""),
+		Secure: !c.GetInsecure(),
+		Region: c.GetRegion(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	exists, err := client.BucketExists(ctx, c.GetBucket())
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, c.GetBucket(), minio.MakeBucketOptions{Region: c.GetRegion()}); err != nil {
+			return nil, err
+		}
+		lg.Infof("created bucket %s", c.GetBucket())
+	}
+	return client, nil
+}
@@ -42,7 +42,7 @@ func connMongo(c *conf.Data, logger log.Logger, database string) (*mongo.Databas
 
 	log.NewHelper(logger).Info("pinging mongodb")
 	var result bson.M
-	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{"ping", 1}}).Decode(&result); err != nil {
+	if err := client.Database("admin").RunCommand(context.TODO(), bson.D{{Key: "ping", Value: 1}}).Decode(&result); err != nil {
 		log.NewHelper(logger).Error("failed to ping mongodb", err)
 		panic(err)
 	}
@@ -0,0 +1,30 @@
+package dep
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nats-io/nats.go"
+	"quiz/internal/conf"
+)
+
+func NewNats(c *conf.Events_Nats, logger log.Logger) (*nats.Conn, func(), error) {
+	lg := log.NewHelper(logger)
+	lg.Debug("Initiating NewNats")
+	conn, err := nats.Connect(c.GetUrl(),
+		nats.Name("quizzes"),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				lg.Warn("nats disconnected", err)
+			}
+		}),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		if err := conn.Drain(); err != nil {
+			lg.Error("drain nats error", err)
+		}
+	}
+	return conn, cleanup, nil
+}
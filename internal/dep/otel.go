@@ -3,9 +3,13 @@ package dep
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"time"
 
 	"quiz/internal/conf"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"go.opentelemetry.io/otel"
@@ -20,37 +24,32 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
 	"go.opentelemetry.io/otel/trace"
 
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 )
 
 func buildResource(meta *conf.AppMetadata) *resource.Resource {
 	r := resource.NewWithAttributes(
 		semconv.SchemaURL,
-		semconv.ServiceNameKey.String(meta.Name),
-		attribute.String("environment", meta.Env.String()),
-		attribute.String("service", meta.Name),
+		semconv.ServiceNameKey.String(meta.GetName()),
+		attribute.String("environment", meta.GetEnv().String()),
+		attribute.String("service", meta.GetName()),
 	)
 	return r
 }
 
 func NewMeter(bc *conf.Bootstrap, provider metric.MeterProvider) (metric.Meter, error) {
-	meta := bc.Metadata
-
-	return provider.Meter(meta.Name), nil
+	return provider.Meter(bc.GetMetadata().GetName()), nil
 }
 
 func NewMeterProvider(bc *conf.Bootstrap) (metric.MeterProvider, error) {
-	meta := bc.Metadata
-	if bc.Otel == nil {
-		return nil, errors.New("missing Otel configuration")
-	}
-	metricConf := bc.Otel.Metrics
 	exporter, err := prometheus.New()
 	if err != nil {
 		return nil, err
 	}
 
-	if metricConf.EnableExemplar {
+	if bc.GetOtel().GetMetrics().GetEnableExemplar() {
 		err = metrics.EnableOTELExemplar()
 		if err != nil {
 			return nil, err
@@ -58,7 +57,7 @@ func NewMeterProvider(bc *conf.Bootstrap) (metric.MeterProvider, error) {
 	}
 
 	provider := sdkmetric.NewMeterProvider(
-		sdkmetric.WithResource(buildResource(meta)),
+		sdkmetric.WithResource(buildResource(bc.GetMetadata())),
 		sdkmetric.WithReader(exporter),
 		sdkmetric.WithView(
 			metrics.DefaultSecondsHistogramView(metrics.DefaultServerSecondsHistogramName),
@@ -68,34 +67,122 @@ func NewMeterProvider(bc *conf.Bootstrap) (metric.MeterProvider, error) {
 	return provider, nil
 }
 
-func NewTracerProvider(ctx context.Context, bc *conf.Bootstrap, textMapPropagator propagation.TextMapPropagator) (trace.TracerProvider, error) {
-	meta := bc.Metadata
-	traceConf := bc.Otel.Trace
+const (
+	TraceExporterNone     = "none"
+	TraceExporterStdout   = "stdout"
+	TraceExporterOtlpHTTP = "otlp_http"
+	TraceExporterOtlpGRPC = "otlp_grpc"
+	TraceExporterFile     = "file"
 
-	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(traceConf.Endpoint)}
-	if traceConf.Insecure {
-		opts = append(opts, otlptracehttp.WithInsecure())
-	}
-	client := otlptracehttp.NewClient(opts...)
-	exp, err := otlptrace.New(ctx, client)
+	traceShutdownTimeout = 5 * time.Second
+)
+
+// NewTracerProvider records spans with the sampler and exporter under
+// otel.trace. Without an exporter spans are still created, so that logs keep
+// their trace IDs. An unreachable collector never stops the service: exports
+// are retried in the background and their failures logged.
+func NewTracerProvider(ctx context.Context, bc *conf.Bootstrap, textMapPropagator propagation.TextMapPropagator, logger log.Logger) (trace.TracerProvider, func(), error) {
+	lg := log.NewHelper(logger)
+	traceConf := bc.GetOtel().GetTrace()
+
+	exp, closeExp, err := newSpanExporter(ctx, traceConf)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(buildResource(bc.GetMetadata())),
+		sdktrace.WithSampler(newSampler(traceConf.GetSampler())),
+	}
+	if exp != nil {
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	}
+	tp := sdktrace.NewTracerProvider(opts...)
 
-	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(exp),
-		sdktrace.WithResource(buildResource(meta)),
-	)
-
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		lg.Warnf("opentelemetry: %v", err)
+	}))
 	otel.SetTracerProvider(tp)
 	otel.SetTextMapPropagator(textMapPropagator)
 
-	return tp, nil
+	cleanup := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), traceShutdownTimeout)
+		defer cancel()
+		if err := tp.Shutdown(ctx); err != nil {
+			lg.Warnf("failed to flush spans: %v", err)
+		}
+		if closeExp != nil {
+			if err := closeExp(); err != nil {
+				lg.Warn(err)
+			}
+		}
+	}
+	return tp, cleanup, nil
+}
+
+// newSpanExporter returns the configured exporter, nil for none, and what to
+// close once the provider has shut it down.
+func newSpanExporter(ctx context.Context, c *conf.Otel_Trace) (sdktrace.SpanExporter, func() error, error) {
+	exporter := c.GetExporter()
+	if exporter == "" {
+		exporter = TraceExporterNone
+		if c.GetEndpoint() != "" {
+			exporter = TraceExporterOtlpHTTP
+		}
+	}
+	switch exporter {
+	case TraceExporterNone:
+		return nil, nil, nil
+	case TraceExporterStdout:
+		exp, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		return exp, nil, err
+	case TraceExporterFile:
+		if c.GetFile() == "" {
+			return nil, nil, errors.New("otel.trace.file is required by the file exporter")
+		}
+		f, err := os.OpenFile(c.GetFile(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, nil, err
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, nil, err
+		}
+		return exp, f.Close, nil
+	case TraceExporterOtlpHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(c.GetEndpoint())}
+		if c.GetInsecure() {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exp, err := otlptrace.New(ctx, otlptracehttp.NewClient(opts...))
+		return exp, nil, err
+	case TraceExporterOtlpGRPC:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(c.GetEndpoint())}
+		if c.GetInsecure() {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exp, err := otlptrace.New(ctx, otlptracegrpc.NewClient(opts...))
+		return exp, nil, err
+	default:
+		return nil, nil, fmt.Errorf("unknown trace exporter %q", exporter)
+	}
+}
+
+// newSampler records every trace unless a ratio is set, following the
+// decision of the caller unless parent_based is turned off.
+func newSampler(c *conf.Otel_Sampler) sdktrace.Sampler {
+	sampler := sdktrace.AlwaysSample()
+	if c != nil && c.Ratio != nil {
+		sampler = sdktrace.TraceIDRatioBased(c.GetRatio())
+	}
+	if c == nil || c.ParentBased == nil || c.GetParentBased() {
+		sampler = sdktrace.ParentBased(sampler)
+	}
+	return sampler
 }
 
 func NewTracer(bc *conf.Bootstrap, tp trace.TracerProvider) (trace.Tracer, error) {
-	meta := bc.Metadata
-	return tp.Tracer(meta.Name), nil
+	return tp.Tracer(bc.GetMetadata().GetName()), nil
 }
 
 func NewTextMapPropagator() propagation.TextMapPropagator {
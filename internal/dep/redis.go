@@ -0,0 +1,49 @@
+package dep
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"quiz/internal/conf"
+)
+
+type Redis struct {
+	Client *redis.Client
+	Log    *log.Helper
+}
+
+func NewRedis(c *conf.Data, logger log.Logger) (*Redis, func(), error) {
+	lg := log.NewHelper(logger)
+	lg.Debug("Initiating NewRedis")
+	opts := &redis.Options{
+		Network: c.GetRedis().GetNetwork(),
+		Addr:    c.GetRedis().GetAddr(),
+	}
+	if t := c.GetRedis().GetReadTimeout(); t != nil {
+		opts.ReadTimeout = t.AsDuration()
+	}
+	if t := c.GetRedis().GetWriteTimeout(); t != nil {
+		opts.WriteTimeout = t.AsDuration()
+	}
+	client := redis.NewClient(opts)
+
+	lg.Debug("pinging redis")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		if err := client.Close(); err != nil {
+			lg.Error("close redis error", err)
+		}
+	}
+	return &Redis{
+		Client: client,
+		Log:    lg,
+	}, cleanup, nil
+}
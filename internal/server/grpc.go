@@ -2,6 +2,7 @@ package server
 
 import (
 	quizzesV1 "quiz/api/quizzes/v1"
+	"quiz/internal/biz"
 	"quiz/internal/conf"
 	"quiz/internal/service"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
@@ -20,6 +22,22 @@ func NewGRPCServer(
 	c *conf.Server,
 	quizzes *service.QuizzesService,
 	questions *service.QuestionsService,
+	products *service.ProductsService,
+	entitlements *service.EntitlementsService,
+	attempts *service.AttemptsService,
+	results *service.ResultsService,
+	leaderboards *service.LeaderboardsService,
+	rooms *service.RoomsService,
+	live *service.LiveQuizService,
+	webhooks *service.WebhooksService,
+	auditLog *service.AuditLogService,
+	collaborators *service.CollaboratorsService,
+	groups *service.GroupsService,
+	assignments *service.AssignmentsService,
+	translations *service.TranslationsService,
+	media *service.MediaService,
+	health *service.HealthService,
+	limiter *biz.RateLimiter,
 	logger log.Logger,
 	meter metric.Meter,
 	tp trace.TracerProvider,
@@ -33,12 +51,17 @@ func NewGRPCServer(
 		return nil, err
 	}
 	var opts = []grpc.ServerOption{
+		// the default health service only knows whether the server runs
+		grpc.CustomHealth(),
 		grpc.Middleware(
 			recovery.Recovery(),
 			tracing.Server(
 				tracing.WithTracerProvider(tp),
 			),
 			logging.Server(logger),
+			service.Actor(),
+			service.RateLimit(limiter),
+			service.Validator(),
 			metrics.Server(
 				metrics.WithRequests(counter),
 				metrics.WithSeconds(seconds),
@@ -57,5 +80,23 @@ func NewGRPCServer(
 	srv := grpc.NewServer(opts...)
 	quizzesV1.RegisterQuizzesServer(srv, quizzes)
 	quizzesV1.RegisterQuestionsServer(srv, questions)
+	quizzesV1.RegisterProductsServer(srv, products)
+	quizzesV1.RegisterEntitlementsServer(srv, entitlements)
+	quizzesV1.RegisterAttemptsServer(srv, attempts)
+	quizzesV1.RegisterResultsServer(srv, results)
+	quizzesV1.RegisterLeaderboardsServer(srv, leaderboards)
+	quizzesV1.RegisterRoomsServer(srv, rooms)
+	quizzesV1.RegisterLiveQuizServer(srv, live)
+	quizzesV1.RegisterWebhooksServer(srv, webhooks)
+	quizzesV1.RegisterAuditLogServer(srv, auditLog)
+	quizzesV1.RegisterCollaboratorsServer(srv, collaborators)
+	quizzesV1.RegisterGroupsServer(srv, groups)
+	quizzesV1.RegisterAssignmentsServer(srv, assignments)
+	quizzesV1.RegisterTranslationsServer(srv, translations)
+	quizzesV1.RegisterMediaServer(srv, media)
+	for name := range srv.GetServiceInfo() {
+		health.AddServices(name)
+	}
+	grpc_health_v1.RegisterHealthServer(srv, health)
 	return srv, nil
 }
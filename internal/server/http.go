@@ -1,8 +1,11 @@
 package server
 
 import (
+	nethttp "net/http"
+
 	"github.com/gorilla/handlers"
 	quizzesV1 "quiz/api/quizzes/v1"
+	"quiz/internal/biz"
 	"quiz/internal/conf"
 	"quiz/internal/service"
 
@@ -22,6 +25,21 @@ func NewHTTPServer(
 	c *conf.Server,
 	quizzes *service.QuizzesService,
 	questions *service.QuestionsService,
+	products *service.ProductsService,
+	entitlements *service.EntitlementsService,
+	attempts *service.AttemptsService,
+	results *service.ResultsService,
+	leaderboards *service.LeaderboardsService,
+	rooms *service.RoomsService,
+	webhooks *service.WebhooksService,
+	auditLog *service.AuditLogService,
+	collaborators *service.CollaboratorsService,
+	groups *service.GroupsService,
+	assignments *service.AssignmentsService,
+	translations *service.TranslationsService,
+	media *service.MediaService,
+	health *service.HealthService,
+	limiter *biz.RateLimiter,
 	logger log.Logger,
 	meter metric.Meter,
 	tp trace.TracerProvider,
@@ -41,6 +59,9 @@ func NewHTTPServer(
 				tracing.WithTracerProvider(tp),
 			),
 			logging.Server(logger),
+			service.Actor(),
+			service.RateLimit(limiter),
+			service.Validator(),
 			metrics.Server(
 				metrics.WithRequests(counter),
 				metrics.WithSeconds(seconds),
@@ -74,8 +95,33 @@ func NewHTTPServer(
 			EnableOpenMetrics: true,
 		},
 	))
+	// plain handlers skip the middleware chain, so they are limited one by one
+	handle := func(path string, h nethttp.HandlerFunc) {
+		srv.HandleFunc(path, service.RateLimitHandler(limiter, path, h))
+	}
+	handle(service.PurchaseWebhookPath, entitlements.PurchaseWebhook)
+	handle(service.RoomSocketPath, rooms.ServeRoom)
+	handle(service.MediaUploadPath, media.Upload)
+	handle(service.MediaContentPath, media.ServeContent)
+	// probes are not limited: kubelets probing from a shared node address
+	// would otherwise get healthy pods restarted
+	srv.HandleFunc(service.LivenessPath, health.Liveness)
+	srv.HandleFunc(service.ReadinessPath, health.Readiness)
 
 	quizzesV1.RegisterQuizzesHTTPServer(srv, quizzes)
 	quizzesV1.RegisterQuestionsHTTPServer(srv, questions)
+	quizzesV1.RegisterProductsHTTPServer(srv, products)
+	quizzesV1.RegisterEntitlementsHTTPServer(srv, entitlements)
+	quizzesV1.RegisterAttemptsHTTPServer(srv, attempts)
+	quizzesV1.RegisterResultsHTTPServer(srv, results)
+	quizzesV1.RegisterLeaderboardsHTTPServer(srv, leaderboards)
+	quizzesV1.RegisterRoomsHTTPServer(srv, rooms)
+	quizzesV1.RegisterWebhooksHTTPServer(srv, webhooks)
+	quizzesV1.RegisterAuditLogHTTPServer(srv, auditLog)
+	quizzesV1.RegisterCollaboratorsHTTPServer(srv, collaborators)
+	quizzesV1.RegisterGroupsHTTPServer(srv, groups)
+	quizzesV1.RegisterAssignmentsHTTPServer(srv, assignments)
+	quizzesV1.RegisterTranslationsHTTPServer(srv, translations)
+	quizzesV1.RegisterMediaHTTPServer(srv, media)
 	return srv, nil
 }
@@ -0,0 +1,14 @@
+package server
+
+import (
+	"quiz/internal/biz"
+)
+
+// OutboxServer runs the event relay.
+type OutboxServer struct {
+	worker
+}
+
+func NewOutboxServer(relay *biz.EventRelay) *OutboxServer {
+	return &OutboxServer{worker{run: relay.Run}}
+}
@@ -5,4 +5,4 @@ import (
 )
 
 // ProviderSet is server providers.
-var SrvrProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer)
+var SrvrProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewOutboxServer, NewWebhookServer)
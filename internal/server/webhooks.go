@@ -0,0 +1,14 @@
+package server
+
+import (
+	"quiz/internal/biz"
+)
+
+// WebhookServer runs the webhook dispatcher.
+type WebhookServer struct {
+	worker
+}
+
+func NewWebhookServer(webhooks *biz.WebhooksUsecase) *WebhookServer {
+	return &WebhookServer{worker{run: webhooks.Run}}
+}
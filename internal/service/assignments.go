@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type AssignmentsService struct {
+	pb.UnimplementedAssignmentsServer
+	uc     *biz.AssignmentsUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewAssignmentsService(uc *biz.AssignmentsUsecase, logger log.Logger, tracer trace.Tracer) *AssignmentsService {
+	return &AssignmentsService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *AssignmentsService) CreateAssignment(ctx context.Context, req *pb.CreateAssignmentRequest) (*pb.CreateAssignmentResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.AssignmentsService.CreateAssignment")
+	defer span.End()
+
+	a := &biz.Assignment{
+		QuizID:      req.GetQuizId(),
+		GroupID:     req.GetGroupId(),
+		MaxAttempts: req.GetMaxAttempts(),
+	}
+	opensAt, err := optionalTime("opens_at", req.OpensAt)
+	if err != nil {
+		return nil, err
+	}
+	if opensAt != nil {
+		a.OpensAt = *opensAt
+	}
+	if a.ClosesAt, err = optionalTime("closes_at", req.ClosesAt); err != nil {
+		return nil, err
+	}
+	if a.DueAt, err = optionalTime("due_at", req.DueAt); err != nil {
+		return nil, err
+	}
+	if a.ClosesAt != nil && a.ClosesAt.IsZero() {
+		a.ClosesAt = nil
+	}
+	if a.DueAt != nil && a.DueAt.IsZero() {
+		a.DueAt = nil
+	}
+
+	res, err := s.uc.CreateAssignment(ctx, a, userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.CreateAssignmentResponse{Assignment: biz.AssignmentToPb(res)}, nil
+}
+
+func (s *AssignmentsService) GetAssignment(ctx context.Context, req *pb.GetAssignmentRequest) (*pb.GetAssignmentResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.AssignmentsService.GetAssignment")
+	defer span.End()
+
+	a, err := s.uc.GetAssignment(ctx, req.GetId(), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.GetAssignmentResponse{Assignment: biz.AssignmentToPb(a)}, nil
+}
+
+func (s *AssignmentsService) ListAssignments(ctx context.Context, req *pb.ListAssignmentsRequest) (*pb.ListAssignmentsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.AssignmentsService.ListAssignments")
+	defer span.End()
+
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	assignments, err := s.uc.ListAssignments(ctx, req.GetGroupId(), userIDFromContext(ctx), pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := &pb.ListAssignmentsResponse{
+		Assignments: make([]*pb.Assignment, 0, len(assignments)),
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}
+	for _, a := range assignments {
+		res.Assignments = append(res.Assignments, biz.AssignmentToPb(a))
+	}
+	return res, nil
+}
+
+func (s *AssignmentsService) UpdateAssignment(ctx context.Context, req *pb.UpdateAssignmentRequest) (*pb.UpdateAssignmentResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.AssignmentsService.UpdateAssignment")
+	defer span.End()
+
+	update := &biz.AssignmentUpdate{
+		ID:          req.GetId(),
+		MaxAttempts: req.MaxAttempts,
+	}
+	var err error
+	if update.OpensAt, err = optionalTime("opens_at", req.OpensAt); err != nil {
+		return nil, err
+	}
+	if update.OpensAt != nil && update.OpensAt.IsZero() {
+		return nil, pb.ErrorInvalidArgument("opens_at can't be cleared")
+	}
+	if update.ClosesAt, err = optionalTime("closes_at", req.ClosesAt); err != nil {
+		return nil, err
+	}
+	if update.DueAt, err = optionalTime("due_at", req.DueAt); err != nil {
+		return nil, err
+	}
+
+	a, err := s.uc.UpdateAssignment(ctx, update, userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.UpdateAssignmentResponse{Assignment: biz.AssignmentToPb(a)}, nil
+}
+
+func (s *AssignmentsService) DeleteAssignment(ctx context.Context, req *pb.DeleteAssignmentRequest) (*pb.DeleteAssignmentResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.AssignmentsService.DeleteAssignment")
+	defer span.End()
+
+	if err := s.uc.DeleteAssignment(ctx, req.GetId(), userIDFromContext(ctx)); err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.DeleteAssignmentResponse{Id: req.GetId()}, nil
+}
+
+func (s *AssignmentsService) GetAssignmentRoster(ctx context.Context, req *pb.GetAssignmentRosterRequest) (*pb.GetAssignmentRosterResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.AssignmentsService.GetAssignmentRoster")
+	defer span.End()
+
+	roster, err := s.uc.GetRoster(ctx, req.GetId(), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := &pb.GetAssignmentRosterResponse{
+		Assignment: biz.AssignmentToPb(roster.Assignment),
+		Entries:    make([]*pb.RosterEntry, 0, len(roster.Entries)),
+		Completed:  roster.Completed,
+		Pending:    roster.Pending,
+	}
+	for _, e := range roster.Entries {
+		res.Entries = append(res.Entries, biz.RosterEntryToPb(e))
+	}
+	return res, nil
+}
+
+// optionalTime parses an optional RFC3339 field. An empty value yields the
+// zero time, which clears the field in updates.
+func optionalTime(field string, v *string) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if *v == "" {
+		return &time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, *v)
+	if err != nil {
+		return nil, pb.ErrorInvalidArgument("invalid %s: %v", field, err)
+	}
+	return &t, nil
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type AttemptsService struct {
+	pb.UnimplementedAttemptsServer
+	uc     *biz.AttemptsUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewAttemptsService(uc *biz.AttemptsUsecase, logger log.Logger, tracer trace.Tracer) *AttemptsService {
+	return &AttemptsService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *AttemptsService) StartAttempt(ctx context.Context, req *pb.StartAttemptRequest) (*pb.StartAttemptResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.AttemptsService.StartAttempt")
+	defer span.End()
+
+	attempt, questions, err := s.uc.StartAttempt(ctx, req.GetQuizId(), userIDFromContext(ctx), req.Seed)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := &pb.StartAttemptResponse{
+		Attempt:   biz.AttemptToPb(attempt),
+		Questions: questionsToPb(questions),
+	}
+	if attempt.Token != "" {
+		res.AttemptToken = &attempt.Token
+	}
+	return res, nil
+}
+func (s *AttemptsService) GetAttempt(ctx context.Context, req *pb.GetAttemptRequest) (*pb.GetAttemptResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.AttemptsService.GetAttempt")
+	defer span.End()
+
+	attempt, questions, err := s.uc.GetAttempt(ctx, req.GetId(), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.GetAttemptResponse{
+		Attempt:   biz.AttemptToPb(attempt),
+		Questions: questionsToPb(questions),
+	}, nil
+}
+func (s *AttemptsService) AnswerQuestion(ctx context.Context, req *pb.AnswerQuestionRequest) (*pb.AnswerQuestionResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.AttemptsService.AnswerQuestion")
+	defer span.End()
+
+	response := biz.AttemptResponse{QuestionID: req.GetQuestionId(), Answers: attemptAnswersFromPb(req.GetAnswers())}
+	result, next, attempt, err := s.uc.AnswerQuestion(ctx, req.GetId(), userIDFromContext(ctx), response)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := &pb.AnswerQuestionResponse{
+		Result: &pb.QuestionResponse{
+			QuestionId:  result.QuestionID,
+			Answers:     req.GetAnswers(),
+			Score:       result.Score,
+			Correct:     result.Correct,
+			TimeSpentMs: proto.Int64(result.TimeSpent.Milliseconds()),
+		},
+		Attempt: biz.AttemptToPb(attempt),
+	}
+	if next != nil {
+		res.Next = biz.QuestionToPb(next)
+	}
+	return res, nil
+}
+func (s *AttemptsService) SubmitAttempt(ctx context.Context, req *pb.SubmitAttemptRequest) (*pb.SubmitAttemptResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.AttemptsService.SubmitAttempt")
+	defer span.End()
+
+	responses := make([]biz.AttemptResponse, 0, len(req.GetResponses()))
+	for _, r := range req.GetResponses() {
+		responses = append(responses, biz.AttemptResponse{
+			QuestionID: r.GetQuestionId(),
+			Answers:    attemptAnswersFromPb(r.GetAnswers()),
+			TimeSpent:  time.Duration(r.GetTimeSpentMs()) * time.Millisecond,
+		})
+	}
+	attempt, err := s.uc.SubmitAttempt(ctx, req.GetId(), userIDFromContext(ctx), responses)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.SubmitAttemptResponse{
+		Attempt: biz.AttemptToPb(attempt),
+	}, nil
+}
+
+func questionsToPb(questions []*biz.Question) []*pb.Question {
+	res := make([]*pb.Question, 0, len(questions))
+	for _, q := range questions {
+		res = append(res, biz.QuestionToPb(q))
+	}
+	return res
+}
+
+func attemptAnswersFromPb(answers []*pb.UserAnswer) []biz.AttemptAnswer {
+	res := make([]biz.AttemptAnswer, 0, len(answers))
+	for _, a := range answers {
+		res = append(res, biz.AttemptAnswer{AnswerID: a.GetAnswerId(), Checked: a.GetChecked()})
+	}
+	return res
+}
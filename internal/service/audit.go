@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+// Actor puts the caller into the context so usecases can attribute the
+// changes they audit, along with the token of an anonymous attempt if given.
+func Actor() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			if userID := userIDFromContext(ctx); userID != "" {
+				ctx = biz.WithActor(ctx, userID)
+			}
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if token := tr.RequestHeader().Get(attemptTokenHeader); token != "" {
+					ctx = biz.WithAttemptToken(ctx, token)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+type AuditLogService struct {
+	pb.UnimplementedAuditLogServer
+	uc     *biz.AuditUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewAuditLogService(uc *biz.AuditUsecase, logger log.Logger, tracer trace.Tracer) *AuditLogService {
+	return &AuditLogService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *AuditLogService) ListAuditEntries(ctx context.Context, req *pb.ListAuditEntriesRequest) (*pb.ListAuditEntriesResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.AuditLogService.ListAuditEntries")
+	defer span.End()
+
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	filter := &biz.AuditFilter{
+		EntityType: req.GetEntityType(),
+		EntityID:   req.GetEntityId(),
+		ActorID:    req.GetActorId(),
+	}
+	entries, err := s.uc.ListEntries(ctx, filter, userIDFromContext(ctx), pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := &pb.ListAuditEntriesResponse{
+		Entries: make([]*pb.AuditEntry, 0, len(entries)),
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}
+	for _, e := range entries {
+		res.Entries = append(res.Entries, biz.AuditEntryToPb(e))
+	}
+	return res, nil
+}
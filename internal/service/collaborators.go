@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type CollaboratorsService struct {
+	pb.UnimplementedCollaboratorsServer
+	uc     *biz.CollaboratorsUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewCollaboratorsService(uc *biz.CollaboratorsUsecase, logger log.Logger, tracer trace.Tracer) *CollaboratorsService {
+	return &CollaboratorsService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *CollaboratorsService) InviteCollaborator(ctx context.Context, req *pb.InviteCollaboratorRequest) (*pb.InviteCollaboratorResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.CollaboratorsService.InviteCollaborator")
+	defer span.End()
+
+	c, err := s.uc.Invite(ctx, req.GetQuizId(), req.GetUserId(), biz.CollaboratorRole(req.GetRole()), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.InviteCollaboratorResponse{Collaborator: biz.CollaboratorToPb(c)}, nil
+}
+
+func (s *CollaboratorsService) AcceptCollaboration(ctx context.Context, req *pb.AcceptCollaborationRequest) (*pb.AcceptCollaborationResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.CollaboratorsService.AcceptCollaboration")
+	defer span.End()
+
+	c, err := s.uc.Accept(ctx, req.GetQuizId(), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.AcceptCollaborationResponse{Collaborator: biz.CollaboratorToPb(c)}, nil
+}
+
+func (s *CollaboratorsService) ListCollaborators(ctx context.Context, req *pb.ListCollaboratorsRequest) (*pb.ListCollaboratorsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.CollaboratorsService.ListCollaborators")
+	defer span.End()
+
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	collaborators, err := s.uc.List(ctx, req.GetQuizId(), userIDFromContext(ctx), pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := &pb.ListCollaboratorsResponse{
+		Collaborators: make([]*pb.Collaborator, 0, len(collaborators)),
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}
+	for _, c := range collaborators {
+		res.Collaborators = append(res.Collaborators, biz.CollaboratorToPb(c))
+	}
+	return res, nil
+}
+
+func (s *CollaboratorsService) RemoveCollaborator(ctx context.Context, req *pb.RemoveCollaboratorRequest) (*pb.RemoveCollaboratorResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.CollaboratorsService.RemoveCollaborator")
+	defer span.End()
+
+	c, err := s.uc.Remove(ctx, req.GetQuizId(), req.GetUserId(), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.RemoveCollaboratorResponse{QuizId: c.QuizID, UserId: c.UserID}, nil
+}
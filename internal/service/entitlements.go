@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	// PurchaseWebhookPath receives purchase notifications from the payment provider.
+	PurchaseWebhookPath = "/webhooks/purchases"
+
+	signatureHeader          = "X-Signature"
+	signatureTimestampHeader = "X-Signature-Timestamp"
+	maxWebhookBody           = 64 << 10
+)
+
+type EntitlementsService struct {
+	pb.UnimplementedEntitlementsServer
+	uc     *biz.EntitlementsUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewEntitlementsService(uc *biz.EntitlementsUsecase, logger log.Logger, tracer trace.Tracer) *EntitlementsService {
+	return &EntitlementsService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *EntitlementsService) GrantEntitlement(ctx context.Context, req *pb.GrantEntitlementRequest) (*pb.GrantEntitlementResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.EntitlementsService.GrantEntitlement")
+	defer span.End()
+	expiresAt, err := parseTime(req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.uc.Grant(ctx, &biz.Entitlement{
+		UserID:    req.GetUserId(),
+		ProductID: req.GetProductId(),
+		Source:    biz.EntitlementSourceAdmin,
+		Reference: req.GetReference(),
+		GrantedBy: userIDFromContext(ctx),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.GrantEntitlementResponse{Entitlement: biz.EntitlementToPb(res)}, nil
+}
+func (s *EntitlementsService) RevokeEntitlement(ctx context.Context, req *pb.RevokeEntitlementRequest) (*pb.RevokeEntitlementResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.EntitlementsService.RevokeEntitlement")
+	defer span.End()
+	res, err := s.uc.Revoke(ctx, req.GetId())
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.RevokeEntitlementResponse{Entitlement: biz.EntitlementToPb(res)}, nil
+}
+func (s *EntitlementsService) SetEntitlementExpiry(ctx context.Context, req *pb.SetEntitlementExpiryRequest) (*pb.SetEntitlementExpiryResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.EntitlementsService.SetEntitlementExpiry")
+	defer span.End()
+	expiresAt, err := parseTime(req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.uc.SetExpiry(ctx, req.GetId(), expiresAt)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.SetEntitlementExpiryResponse{Entitlement: biz.EntitlementToPb(res)}, nil
+}
+func (s *EntitlementsService) ListEntitlements(ctx context.Context, req *pb.ListEntitlementsRequest) (*pb.ListEntitlementsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.EntitlementsService.ListEntitlements")
+	defer span.End()
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	res, err := s.uc.List(ctx, req.GetUserId(), req.GetActiveOnly(), pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	entitlements := make([]*pb.Entitlement, 0, len(res))
+	for _, e := range res {
+		entitlements = append(entitlements, biz.EntitlementToPb(e))
+	}
+	return &pb.ListEntitlementsResponse{
+		Entitlements: entitlements,
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}, nil
+}
+
+// PurchaseWebhook accepts signed purchase notifications. The signature has to be
+// computed over the raw body, so it is served as a plain handler rather than an RPC.
+func (s *EntitlementsService) PurchaseWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx, span := s.tracer.Start(r.Context(), "service.EntitlementsService.PurchaseWebhook")
+	defer span.End()
+	if r.Method != http.MethodPost {
+		writeError(w, pb.ErrorMethodNotAllowed("only POST is supported"))
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		writeError(w, pb.ErrorInvalidArgument("invalid notification: %v", err))
+		return
+	}
+	if err := s.uc.VerifySignature(body, r.Header.Get(signatureTimestampHeader), r.Header.Get(signatureHeader)); err != nil {
+		s.log.Warn(err)
+		writeError(w, err)
+		return
+	}
+	res, err := s.uc.HandlePurchase(ctx, body)
+	if err != nil {
+		s.log.Warn(err)
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(biz.EntitlementToPb(res))
+}
+
+// writeError renders err the same way the kratos HTTP transport does.
+func writeError(w http.ResponseWriter, err error) {
+	se := errors.FromError(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(int(se.Code))
+	_ = json.NewEncoder(w).Encode(se)
+}
+
+// parseTime parses an optional RFC 3339 timestamp, treating empty values as unset.
+func parseTime(v *string) (*time.Time, error) {
+	if v == nil || *v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *v)
+	if err != nil {
+		return nil, pb.ErrorInvalidArgument("invalid timestamp: %v", err)
+	}
+	return &t, nil
+}
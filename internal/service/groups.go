@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type GroupsService struct {
+	pb.UnimplementedGroupsServer
+	uc     *biz.GroupsUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewGroupsService(uc *biz.GroupsUsecase, logger log.Logger, tracer trace.Tracer) *GroupsService {
+	return &GroupsService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *GroupsService) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*pb.CreateGroupResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.GroupsService.CreateGroup")
+	defer span.End()
+
+	g, err := s.uc.CreateGroup(ctx, &biz.Group{
+		OwnerID:     userIDFromContext(ctx),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		MemberIDs:   req.GetMemberIds(),
+	})
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.CreateGroupResponse{Group: biz.GroupToPb(g)}, nil
+}
+
+func (s *GroupsService) GetGroup(ctx context.Context, req *pb.GetGroupRequest) (*pb.GetGroupResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.GroupsService.GetGroup")
+	defer span.End()
+
+	g, err := s.uc.GetGroup(ctx, req.GetId(), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.GetGroupResponse{Group: biz.GroupToPb(g)}, nil
+}
+
+func (s *GroupsService) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.GroupsService.ListGroups")
+	defer span.End()
+
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	groups, err := s.uc.ListGroups(ctx, userIDFromContext(ctx), pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := &pb.ListGroupsResponse{
+		Groups: make([]*pb.Group, 0, len(groups)),
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}
+	for _, g := range groups {
+		res.Groups = append(res.Groups, biz.GroupToPb(g))
+	}
+	return res, nil
+}
+
+func (s *GroupsService) UpdateGroup(ctx context.Context, req *pb.UpdateGroupRequest) (*pb.UpdateGroupResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.GroupsService.UpdateGroup")
+	defer span.End()
+
+	g, err := s.uc.UpdateGroup(ctx, &biz.Group{
+		ID:          req.GetId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}, userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.UpdateGroupResponse{Group: biz.GroupToPb(g)}, nil
+}
+
+func (s *GroupsService) DeleteGroup(ctx context.Context, req *pb.DeleteGroupRequest) (*pb.DeleteGroupResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.GroupsService.DeleteGroup")
+	defer span.End()
+
+	if err := s.uc.DeleteGroup(ctx, req.GetId(), userIDFromContext(ctx)); err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.DeleteGroupResponse{Id: req.GetId()}, nil
+}
+
+func (s *GroupsService) AddGroupMembers(ctx context.Context, req *pb.AddGroupMembersRequest) (*pb.AddGroupMembersResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.GroupsService.AddGroupMembers")
+	defer span.End()
+
+	g, err := s.uc.AddMembers(ctx, req.GetId(), req.GetUserIds(), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.AddGroupMembersResponse{Group: biz.GroupToPb(g)}, nil
+}
+
+func (s *GroupsService) RemoveGroupMember(ctx context.Context, req *pb.RemoveGroupMemberRequest) (*pb.RemoveGroupMemberResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.GroupsService.RemoveGroupMember")
+	defer span.End()
+
+	g, err := s.uc.RemoveMember(ctx, req.GetId(), req.GetUserId(), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.RemoveGroupMemberResponse{Group: biz.GroupToPb(g)}, nil
+}
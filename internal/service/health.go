@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	// LivenessPath answers as long as the process serves HTTP; restarting it
+	// would not bring a database back.
+	LivenessPath = "/healthz"
+	// ReadinessPath reports each dependency, failing when one is down.
+	ReadinessPath = "/readyz"
+	// healthOperationPrefix starts the operations of the gRPC health service.
+	healthOperationPrefix = "/grpc.health.v1.Health/"
+
+	healthWatchInterval = 5 * time.Second
+)
+
+type dependencyReport struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+type readinessReport struct {
+	Status       string             `json:"status"`
+	CheckedAt    time.Time          `json:"checked_at"`
+	Dependencies []dependencyReport `json:"dependencies"`
+}
+
+// HealthService serves the probes over HTTP and the standard gRPC health
+// service, answering for the server as a whole ("") and for each service
+// registered with AddServices.
+type HealthService struct {
+	grpc_health_v1.UnimplementedHealthServer
+	uc       *biz.HealthUsecase
+	mu       sync.RWMutex
+	services map[string]bool
+	log      *log.Helper
+	tracer   trace.Tracer
+}
+
+func NewHealthService(uc *biz.HealthUsecase, logger log.Logger, tracer trace.Tracer) *HealthService {
+	return &HealthService{
+		uc:       uc,
+		services: map[string]bool{"": true},
+		log:      log.NewHelper(logger),
+		tracer:   tracer,
+	}
+}
+
+// AddServices makes the gRPC health service answer for names.
+func (s *HealthService) AddServices(names ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, name := range names {
+		s.services[name] = true
+	}
+}
+
+// Shutdown fails readiness from now on.
+func (s *HealthService) Shutdown(context.Context) error {
+	s.uc.Drain()
+	return nil
+}
+
+func (s *HealthService) Liveness(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+}
+
+func (s *HealthService) Readiness(w http.ResponseWriter, r *http.Request) {
+	ctx, span := s.tracer.Start(r.Context(), "service.HealthService.Readiness")
+	defer span.End()
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, pb.ErrorMethodNotAllowed("only GET is supported"))
+		return
+	}
+
+	report := s.uc.Ready(ctx)
+	res := readinessReport{
+		Status:       "ok",
+		CheckedAt:    report.CheckedAt,
+		Dependencies: make([]dependencyReport, 0, len(report.Dependencies)),
+	}
+	for _, d := range report.Dependencies {
+		dep := dependencyReport{
+			Name:      d.Name,
+			Status:    "ok",
+			LatencyMs: float64(d.Latency.Microseconds()) / 1000,
+			Error:     d.Error,
+		}
+		if !d.Healthy {
+			dep.Status = "down"
+		}
+		res.Dependencies = append(res.Dependencies, dep)
+	}
+	code := http.StatusOK
+	switch {
+	case report.Draining:
+		res.Status, code = "draining", http.StatusServiceUnavailable
+	case !report.Ready:
+		res.Status, code = "unavailable", http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func (s *HealthService) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.HealthService.Check")
+	defer span.End()
+
+	if !s.known(req.GetService()) {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: s.status(ctx)}, nil
+}
+
+// Watch sends the status of the requested service, then again whenever it
+// changes, checking every healthWatchInterval.
+func (s *HealthService) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	ctx := stream.Context()
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	last := grpc_health_v1.HealthCheckResponse_UNKNOWN
+	for {
+		current := grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+		if s.known(req.GetService()) {
+			current = s.status(ctx)
+		}
+		if current != last {
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: current}); err != nil {
+				return err
+			}
+			last = current
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *HealthService) known(service string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.services[service]
+}
+
+func (s *HealthService) status(ctx context.Context) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if s.uc.Ready(ctx).Ready {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type LeaderboardsService struct {
+	pb.UnimplementedLeaderboardsServer
+	uc     *biz.LeaderboardUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewLeaderboardsService(uc *biz.LeaderboardUsecase, logger log.Logger, tracer trace.Tracer) *LeaderboardsService {
+	return &LeaderboardsService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *LeaderboardsService) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.LeaderboardsService.GetLeaderboard")
+	defer span.End()
+
+	at := time.Now()
+	if req.At != nil {
+		var err error
+		at, err = time.Parse(time.RFC3339, req.GetAt())
+		if err != nil {
+			return nil, pb.ErrorInvalidArgument("invalid at: %v", err)
+		}
+	}
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	entries, me, err := s.uc.GetLeaderboard(ctx, req.GetQuizId(), leaderboardWindowFromPb(req.GetWindow()), at, userIDFromContext(ctx), pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := &pb.GetLeaderboardResponse{
+		Entries: make([]*pb.LeaderboardEntry, 0, len(entries)),
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}
+	for _, e := range entries {
+		res.Entries = append(res.Entries, biz.LeaderboardEntryToPb(e))
+	}
+	if me != nil {
+		res.Me = biz.LeaderboardEntryToPb(me)
+	}
+	return res, nil
+}
+
+func (s *LeaderboardsService) RebuildLeaderboard(ctx context.Context, req *pb.RebuildLeaderboardRequest) (*pb.RebuildLeaderboardResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.LeaderboardsService.RebuildLeaderboard")
+	defer span.End()
+
+	n, err := s.uc.Rebuild(ctx, req.GetQuizId(), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.RebuildLeaderboardResponse{Attempts: n}, nil
+}
+
+func leaderboardWindowFromPb(w pb.LeaderboardWindow) biz.LeaderboardWindow {
+	switch w {
+	case pb.LeaderboardWindow_WEEKLY:
+		return biz.LeaderboardWeekly
+	case pb.LeaderboardWindow_DAILY:
+		return biz.LeaderboardDaily
+	default:
+		return biz.LeaderboardAllTime
+	}
+}
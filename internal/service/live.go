@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+// LiveQuizService is the gRPC face of the rooms served over WebSocket by
+// RoomsService.
+type LiveQuizService struct {
+	pb.UnimplementedLiveQuizServer
+	uc     *biz.RoomsUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewLiveQuizService(uc *biz.RoomsUsecase, logger log.Logger, tracer trace.Tracer) *LiveQuizService {
+	return &LiveQuizService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *LiveQuizService) Subscribe(req *pb.SubscribeLiveRequest, stream grpc.ServerStreamingServer[pb.LiveEvent]) error {
+	ctx, span := s.tracer.Start(stream.Context(), "service.LiveQuizService.Subscribe")
+	defer span.End()
+
+	userID := userIDFromContext(ctx)
+	sub, err := s.uc.Subscribe(ctx, req.GetPin(), userID)
+	if err != nil {
+		s.log.Warn(err)
+		return err
+	}
+	defer func() {
+		_ = s.uc.Act(context.Background(), req.GetPin(), &biz.RoomAction{Type: biz.RoomActionLeave, UserID: userID})
+	}()
+	for e := range sub.C {
+		for _, event := range liveEventsToPb(e) {
+			// Send blocks while the client is slow to read, which is what
+			// lets the subscription notice it falling behind
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+	if err := sub.Err(); err != nil {
+		s.log.Warn(err)
+		return err
+	}
+	return ctx.Err()
+}
+
+func (s *LiveQuizService) JoinRoom(ctx context.Context, req *pb.JoinRoomRequest) (*pb.JoinRoomResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.LiveQuizService.JoinRoom")
+	defer span.End()
+
+	if err := s.uc.Join(ctx, req.GetPin(), userIDFromContext(ctx), req.GetName()); err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.JoinRoomResponse{}, nil
+}
+
+func (s *LiveQuizService) SubmitLiveAnswer(ctx context.Context, req *pb.SubmitLiveAnswerRequest) (*pb.SubmitLiveAnswerResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.LiveQuizService.SubmitLiveAnswer")
+	defer span.End()
+
+	err := s.uc.Act(ctx, req.GetPin(), &biz.RoomAction{
+		Type:      biz.RoomActionAnswer,
+		UserID:    userIDFromContext(ctx),
+		AnswerIDs: req.GetAnswerIds(),
+	})
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.SubmitLiveAnswerResponse{}, nil
+}
+
+// liveEventsToPb maps a room event to the stream events it stands for. A
+// reveal both ends the question and updates the scores.
+func liveEventsToPb(e *biz.RoomEvent) []*pb.LiveEvent {
+	switch e.Type {
+	case biz.RoomEventQuestion:
+		answers := make([]*pb.LiveAnswer, 0, len(e.Question.Answers))
+		for _, a := range e.Question.Answers {
+			answers = append(answers, &pb.LiveAnswer{Id: a.ID, Text: a.Text})
+		}
+		return []*pb.LiveEvent{{Event: &pb.LiveEvent_QuestionStarted{QuestionStarted: &pb.QuestionStarted{
+			Index:    uint32(e.Question.Index),
+			Total:    uint32(e.Question.Total),
+			Question: e.Question.Question,
+			Answers:  answers,
+			Deadline: e.Question.Deadline.Format(time.RFC3339),
+		}}}}
+	case biz.RoomEventReveal:
+		return []*pb.LiveEvent{
+			{Event: &pb.LiveEvent_QuestionEnded{QuestionEnded: &pb.QuestionEnded{CorrectAnswerIds: e.Correct}}},
+			{Event: &pb.LiveEvent_ScoreUpdate{ScoreUpdate: &pb.ScoreUpdate{Scores: liveScoresToPb(e.Scores)}}},
+		}
+	case biz.RoomEventFinished:
+		return []*pb.LiveEvent{{Event: &pb.LiveEvent_RoomClosed{RoomClosed: &pb.RoomClosed{Scores: liveScoresToPb(e.Scores)}}}}
+	case biz.RoomEventLobby:
+		players := make([]*pb.LivePlayer, 0, len(e.Players))
+		for _, p := range e.Players {
+			players = append(players, &pb.LivePlayer{UserId: p.UserID, Name: p.Name, Score: p.Score, Connected: p.Connected})
+		}
+		return []*pb.LiveEvent{{Event: &pb.LiveEvent_PlayersUpdate{PlayersUpdate: &pb.PlayersUpdate{Players: players}}}}
+	case biz.RoomEventAnswered:
+		return []*pb.LiveEvent{{Event: &pb.LiveEvent_AnswerAccepted{AnswerAccepted: &pb.AnswerAccepted{}}}}
+	case biz.RoomEventError:
+		return []*pb.LiveEvent{{Event: &pb.LiveEvent_Error{Error: &pb.LiveError{Message: e.Message}}}}
+	}
+	return nil
+}
+
+func liveScoresToPb(scores []biz.RoomScore) []*pb.LiveScore {
+	res := make([]*pb.LiveScore, 0, len(scores))
+	for _, s := range scores {
+		res = append(res, &pb.LiveScore{
+			Rank:   uint32(s.Rank),
+			UserId: s.UserID,
+			Name:   s.Name,
+			Score:  s.Score,
+			Gained: s.Gained,
+		})
+	}
+	return res
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	// MediaUploadPath receives uploads, the request body being the file itself.
+	MediaUploadPath = "/media"
+	// MediaContentPath serves the content of an uploaded file.
+	MediaContentPath = "/media/{id}/content"
+)
+
+type MediaService struct {
+	pb.UnimplementedMediaServer
+	uc     *biz.MediaUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewMediaService(uc *biz.MediaUsecase, logger log.Logger, tracer trace.Tracer) *MediaService {
+	return &MediaService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *MediaService) GetMedia(ctx context.Context, req *pb.GetMediaRequest) (*pb.GetMediaResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.MediaService.GetMedia")
+	defer span.End()
+
+	m, err := s.uc.GetMedia(ctx, req.GetId())
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.GetMediaResponse{Media: biz.MediaToPb(m)}, nil
+}
+
+func (s *MediaService) DeleteMedia(ctx context.Context, req *pb.DeleteMediaRequest) (*pb.DeleteMediaResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.MediaService.DeleteMedia")
+	defer span.End()
+
+	if err := s.uc.DeleteMedia(ctx, req.GetId(), userIDFromContext(ctx)); err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.DeleteMediaResponse{Id: req.GetId()}, nil
+}
+
+// Upload streams the request body to the blob store. It is served as a plain
+// handler so that files never have to be held in memory as a message.
+func (s *MediaService) Upload(w http.ResponseWriter, r *http.Request) {
+	// transfers outlast the server timeout, which is meant for RPCs; a client
+	// going away still fails the body or the response writes
+	ctx, span := s.tracer.Start(context.WithoutCancel(r.Context()), "service.MediaService.Upload")
+	defer span.End()
+	if r.Method != http.MethodPost {
+		writeError(w, pb.ErrorMethodNotAllowed("only POST is supported"))
+		return
+	}
+	defer r.Body.Close()
+
+	m, err := s.uc.Upload(ctx, r.Header.Get(userIDHeader), r.URL.Query().Get("filename"), r.Body)
+	if err != nil {
+		s.log.Warn(err)
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(biz.MediaToPb(m))
+}
+
+// ServeContent streams an uploaded file back with the content type sniffed at
+// upload. Files are served as attachments unless they are media a browser
+// renders inline without running anything.
+func (s *MediaService) ServeContent(w http.ResponseWriter, r *http.Request) {
+	// transfers outlast the server timeout, which is meant for RPCs; a client
+	// going away still fails the body or the response writes
+	ctx, span := s.tracer.Start(context.WithoutCancel(r.Context()), "service.MediaService.ServeContent")
+	defer span.End()
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, pb.ErrorMethodNotAllowed("only GET is supported"))
+		return
+	}
+
+	m, content, err := s.uc.Open(ctx, mux.Vars(r)["id"])
+	if err != nil {
+		s.log.Warn(err)
+		writeError(w, err)
+		return
+	}
+	defer content.Close()
+
+	disposition := "inline"
+	if m.ContentType == "text/plain" {
+		disposition = "attachment"
+	}
+	if m.Filename != "" {
+		disposition = mime.FormatMediaType(disposition, map[string]string{"filename": m.Filename})
+	}
+	w.Header().Set("Content-Type", m.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(m.Size, 10))
+	w.Header().Set("Content-Disposition", disposition)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("ETag", strconv.Quote(m.Checksum))
+	w.Header().Set("Cache-Control", "private, max-age=86400, immutable")
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := io.Copy(w, content); err != nil {
+		s.log.Warnf("failed to serve media %s: %v", m.ID, err)
+	}
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type ProductsService struct {
+	pb.UnimplementedProductsServer
+	uc     *biz.ProductsUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewProductsService(uc *biz.ProductsUsecase, logger log.Logger, tracer trace.Tracer) *ProductsService {
+	return &ProductsService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *ProductsService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ProductsService.CreateProduct")
+	defer span.End()
+	product := &biz.Product{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Price:       req.GetPrice(),
+		Category:    req.GetCategory(),
+		Tags:        req.Tags,
+		Attributes:  req.Attributes,
+		Thumbnail:   req.Thumbnail,
+		Images:      req.Images,
+		QuizIDs:     req.QuizIds,
+	}
+	id, err := s.uc.CreateProduct(ctx, product)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.CreateProductResponse{
+		Id: id,
+	}, nil
+}
+func (s *ProductsService) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ProductsService.GetProduct")
+	defer span.End()
+	res, err := s.uc.GetProduct(ctx, req.GetId())
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.GetProductResponse{Product: biz.ProductToPb(res)}, nil
+}
+func (s *ProductsService) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ProductsService.ListProducts")
+	defer span.End()
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	res, err := s.uc.ListProducts(ctx, pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	products := make([]*pb.Product, 0, len(res))
+	for _, p := range res {
+		products = append(products, biz.ProductToPb(p))
+	}
+	return &pb.ListProductsResponse{
+		Products: products,
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}, nil
+}
+func (s *ProductsService) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ProductsService.UpdateProduct")
+	defer span.End()
+	product := &biz.Product{
+		ID:          req.GetId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Price:       req.GetPrice(),
+		Category:    req.GetCategory(),
+		Tags:        req.Tags,
+		Attributes:  req.Attributes,
+		Thumbnail:   req.Thumbnail,
+		Images:      req.Images,
+	}
+	res, err := s.uc.UpdateProduct(ctx, product)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.UpdateProductResponse{Product: biz.ProductToPb(res)}, nil
+}
+func (s *ProductsService) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ProductsService.DeleteProduct")
+	defer span.End()
+	id, err := s.uc.DeleteProduct(ctx, req.GetId())
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.DeleteProductResponse{Id: id}, nil
+}
+func (s *ProductsService) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ProductsService.SearchProducts")
+	defer span.End()
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	res, err := s.uc.SearchProducts(ctx, req.GetQuery(), pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	products := make([]*pb.Product, 0, len(res))
+	for _, p := range res {
+		products = append(products, biz.ProductToPb(p))
+	}
+	return &pb.SearchProductsResponse{
+		Products: products,
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}, nil
+}
+func (s *ProductsService) LinkQuizzes(ctx context.Context, req *pb.LinkQuizzesRequest) (*pb.LinkQuizzesResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ProductsService.LinkQuizzes")
+	defer span.End()
+	res, err := s.uc.LinkQuizzes(ctx, req.GetId(), req.GetQuizIds())
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.LinkQuizzesResponse{Product: biz.ProductToPb(res)}, nil
+}
+func (s *ProductsService) UnlinkQuiz(ctx context.Context, req *pb.UnlinkQuizRequest) (*pb.UnlinkQuizResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ProductsService.UnlinkQuiz")
+	defer span.End()
+	res, err := s.uc.UnlinkQuiz(ctx, req.GetId(), req.GetQuizId())
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.UnlinkQuizResponse{Product: biz.ProductToPb(res)}, nil
+}
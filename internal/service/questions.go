@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"go.opentelemetry.io/otel/trace"
 	"quiz/internal/biz"
@@ -35,6 +34,9 @@ func (s *QuestionsService) CreateQuestion(ctx context.Context, req *pb.CreateQue
 		Difficulty: uint64(req.GetDifficulty()),
 		Order:      float64(req.GetOrder()),
 		Hint:       req.GetHint(),
+		Tags:       req.GetTags(),
+		Media:      biz.AttachmentsFromPb(req.GetMedia()),
+		Format:     biz.ContentFormat(req.GetFormat()),
 	}
 
 	answers := req.GetAnswers()
@@ -52,13 +54,13 @@ func (s *QuestionsService) GetQuestion(ctx context.Context, req *pb.GetQuestionR
 	ctx, span := s.tracer.Start(ctx, "service.QuestionsService.GetQuestion")
 	defer span.End()
 
-	question, err := s.uc.GetQuestion(ctx, req.GetQuestionId())
+	question, err := s.uc.GetQuestion(ctx, req.GetQuestionId(), userIDFromContext(ctx), localeFromContext(ctx, req.Locale))
 	if err != nil {
 		s.log.Warn(err)
 		return nil, err
 	}
 	return &pb.GetQuestionResponse{
-		Question: biz.QuestionToPb(question),
+		Question: questionToPb(question, req.GetRenderHtml()),
 	}, nil
 }
 func (s *QuestionsService) ListQuestion(ctx context.Context, req *pb.ListQuestionRequest) (*pb.ListQuestionResponse, error) {
@@ -70,14 +72,14 @@ func (s *QuestionsService) ListQuestion(ctx context.Context, req *pb.ListQuestio
 		Size: req.GetPagination().GetPageSize(),
 	}
 
-	questions, err := s.uc.ListQuestion(ctx, req.GetQuizId(), pagination)
+	questions, err := s.uc.ListQuestion(ctx, req.GetQuizId(), userIDFromContext(ctx), localeFromContext(ctx, req.Locale), pagination)
 	if err != nil {
 		s.log.Warn(err)
 		return nil, err
 	}
 	var resQuestions []*pb.Question
 	for _, q := range questions {
-		resQuestions = append(resQuestions, biz.QuestionToPb(q))
+		resQuestions = append(resQuestions, questionToPb(q, req.GetRenderHtml()))
 	}
 	return &pb.ListQuestionResponse{
 		Questions: resQuestions,
@@ -87,19 +89,66 @@ func (s *QuestionsService) ListQuestion(ctx context.Context, req *pb.ListQuestio
 		},
 	}, nil
 }
+func (s *QuestionsService) ListBankQuestions(ctx context.Context, req *pb.ListBankQuestionsRequest) (*pb.ListBankQuestionsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.QuestionsService.ListBankQuestions")
+	defer span.End()
+
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	var difficulty *uint64
+	if req.Difficulty != nil {
+		d := uint64(req.GetDifficulty())
+		difficulty = &d
+	}
+
+	questions, err := s.uc.ListBankQuestions(ctx, req.GetTags(), difficulty, localeFromContext(ctx, req.Locale), pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	var resQuestions []*pb.Question
+	for _, q := range questions {
+		resQuestions = append(resQuestions, questionToPb(q, req.GetRenderHtml()))
+	}
+	return &pb.ListBankQuestionsResponse{
+		Questions: resQuestions,
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}, nil
+}
 func (s *QuestionsService) UpdateQuestion(ctx context.Context, req *pb.UpdateQuestionRequest) (*pb.UpdateQuestionResponse, error) {
 	ctx, span := s.tracer.Start(ctx, "service.QuestionsService.UpdateQuestion")
 	defer span.End()
 
 	question := &biz.Question{
-		ID:         req.GetQuestionId(),
-		QuizID:     req.GetQuizId(),
-		Question:   req.GetQuestion(),
-		Difficulty: uint64(req.GetDifficulty()),
-		Hint:       req.GetHint(),
+		ID:       req.GetQuestionId(),
+		QuizID:   req.GetQuizId(),
+		Question: req.GetQuestion(),
+		Tags:     req.GetTags(),
+	}
+	var difficulty *uint64
+	if req.Difficulty != nil {
+		d := uint64(req.GetDifficulty())
+		difficulty = &d
+	}
+	if req.Media != nil {
+		question.Media = biz.AttachmentsFromPb(req.GetMedia().GetItems())
+		if question.Media == nil {
+			question.Media = []biz.Attachment{}
+		}
+	}
+
+	var format *biz.ContentFormat
+	if req.Format != nil {
+		f := biz.ContentFormat(req.GetFormat())
+		format = &f
 	}
 
-	res, err := s.uc.UpdateQuestion(ctx, question)
+	res, err := s.uc.UpdateQuestion(ctx, question, req.Hint, difficulty, format)
 	if err != nil {
 		s.log.Warn(err)
 		return nil, err
@@ -126,13 +175,13 @@ func (s *QuestionsService) ReorderQuestion(ctx context.Context, req *pb.ReorderQ
 	ctx, span := s.tracer.Start(ctx, "service.QuestionsService.ReorderQuestion")
 	defer span.End()
 
-	return nil, errors.InternalServer("not implemented", "not implemented")
+	return nil, pb.ErrorNotImplemented("not implemented")
 }
 func (s *QuestionsService) ValidateQuestionAnswers(ctx context.Context, req *pb.ValidateQuestionAnswersRequest) (*pb.ValidateQuestionAnswersResponse, error) {
 	ctx, span := s.tracer.Start(ctx, "service.QuestionsService.ValidateQuestionAnswers")
 	defer span.End()
 
-	res, err := s.uc.ValidateQuestionAnswers(ctx, req.GetQuestionId(), req.GetAnswers())
+	res, err := s.uc.ValidateQuestionAnswers(ctx, req.GetQuestionId(), userIDFromContext(ctx), req.GetAnswers())
 	if err != nil {
 		s.log.Warn(err)
 		return nil, err
@@ -143,7 +192,7 @@ func (s *QuestionsService) AddAnswer(ctx context.Context, req *pb.AddAnswerReque
 	ctx, span := s.tracer.Start(ctx, "service.QuestionsService.AddAnswer")
 	defer span.End()
 
-	answer, err := s.uc.AddAnswer(ctx, req.GetQuestionId(), *req.GetAnswer())
+	answer, err := s.uc.AddAnswer(ctx, req.GetQuestionId(), req.GetAnswer())
 	if err != nil {
 		s.log.Warn(err)
 		return nil, err
@@ -197,3 +246,12 @@ func (s *QuestionsService) ReorderAnswers(ctx context.Context, req *pb.ReorderAn
 	return s.uc.ReorderAnswers(ctx, req)
 
 }
+
+// questionToPb converts q for a read RPC, rendering its text to HTML when the
+// caller asked for it.
+func questionToPb(q *biz.Question, renderHTML bool) *pb.Question {
+	if renderHTML {
+		return biz.QuestionWithHTMLToPb(q)
+	}
+	return biz.QuestionToPb(q)
+}
@@ -29,7 +29,7 @@ func (s *QuizzesService) CreateQuiz(ctx context.Context, req *pb.CreateQuizReque
 	defer span.End()
 	s.log.Debug("CreateQuiz")
 	quiz := &biz.Quiz{
-		UserID:      "01c49030-9c6d-4262-891d-9f4733aa8e31",
+		UserID:      userIDFromContext(ctx),
 		Title:       req.GetTitle(),
 		Description: req.GetDescription(),
 		Duration:    req.Duration,
@@ -38,6 +38,13 @@ func (s *QuizzesService) CreateQuiz(ctx context.Context, req *pb.CreateQuizReque
 		Category:    req.Category,
 		Tags:        req.Tags,
 		Metadata:    req.Metadata,
+		Premium:     req.Premium,
+		QuestionIDs: req.QuestionIds,
+		DrawRules:   drawRulesFromPb(req.DrawRules),
+		Shuffle:     shuffleFromPb(req.Shuffle),
+		Adaptive:    adaptiveFromPb(req.Adaptive),
+
+		DefaultLocale: req.GetDefaultLocale(),
 	}
 	res, err := s.uc.CreateQuiz(ctx, quiz)
 	if err != nil {
@@ -51,7 +58,7 @@ func (s *QuizzesService) CreateQuiz(ctx context.Context, req *pb.CreateQuizReque
 func (s *QuizzesService) GetQuiz(ctx context.Context, req *pb.GetQuizRequest) (*pb.GetQuizResponse, error) {
 	ctx, span := s.tracer.Start(ctx, "service.QuizzesService.GetQuiz")
 	defer span.End()
-	res, err := s.uc.GetQuiz(ctx, req.GetId())
+	res, err := s.uc.GetQuiz(ctx, req.GetId(), userIDFromContext(ctx), localeFromContext(ctx, req.Locale))
 	if err != nil {
 		s.log.Warn(err)
 		return nil, err
@@ -65,7 +72,7 @@ func (s *QuizzesService) ListQuiz(ctx context.Context, req *pb.ListQuizRequest)
 		Page: req.Pagination.GetPage(),
 		Size: req.Pagination.GetPageSize(),
 	}
-	res, err := s.uc.ListQuiz(ctx, biz.PaginationOrDefault(pagination))
+	res, err := s.uc.ListQuiz(ctx, localeFromContext(ctx, req.Locale), biz.PaginationOrDefault(pagination))
 	if err != nil {
 		s.log.Warn(err)
 		return nil, err
@@ -97,6 +104,13 @@ func (s *QuizzesService) UpdateQuiz(ctx context.Context, req *pb.UpdateQuizReque
 		Category:    req.Category,
 		Tags:        req.Tags,
 		Metadata:    req.Metadata,
+		Premium:     req.Premium,
+		QuestionIDs: req.QuestionIds,
+		DrawRules:   drawRulesFromPb(req.DrawRules),
+		Shuffle:     shuffleFromPb(req.Shuffle),
+		Adaptive:    adaptiveFromPb(req.Adaptive),
+
+		DefaultLocale: req.GetDefaultLocale(),
 	}
 	res, err := s.uc.UpdateQuiz(ctx, quiz)
 	if err != nil {
@@ -120,6 +134,71 @@ func (s *QuizzesService) DeleteQuiz(ctx context.Context, req *pb.DeleteQuizReque
 		Id: res.ID,
 	}, nil
 }
+func (s *QuizzesService) PublishQuiz(ctx context.Context, req *pb.PublishQuizRequest) (*pb.PublishQuizResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.QuizzesService.PublishQuiz")
+	defer span.End()
+	res, err := s.uc.PublishQuiz(ctx, req.GetId(), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.PublishQuizResponse{
+		Quiz: biz.QuizToPb(res),
+	}, nil
+}
 func (s *QuizzesService) SearchQuiz(ctx context.Context, req *pb.SearchQuizRequest) (*pb.SearchQuizResponse, error) {
 	return &pb.SearchQuizResponse{}, nil
 }
+
+func (s *QuizzesService) CloneQuiz(ctx context.Context, req *pb.CloneQuizRequest) (*pb.CloneQuizResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.QuizzesService.CloneQuiz")
+	defer span.End()
+	res, questions, err := s.uc.CloneQuiz(ctx, req.GetId(), &biz.CloneOverrides{
+		Title:  req.Title,
+		UserID: req.UserId,
+		Tags:   req.Tags,
+	})
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.CloneQuizResponse{
+		Quiz:      biz.QuizToPb(res),
+		Questions: uint32(questions),
+	}, nil
+}
+
+func drawRulesFromPb(rules []*pb.DrawRule) []biz.DrawRule {
+	if rules == nil {
+		return nil
+	}
+	res := make([]biz.DrawRule, 0, len(rules))
+	for _, r := range rules {
+		rule := biz.DrawRule{Count: r.GetCount(), Tags: r.GetTags()}
+		if r.Difficulty != nil {
+			difficulty := uint64(r.GetDifficulty())
+			rule.Difficulty = &difficulty
+		}
+		res = append(res, rule)
+	}
+	return res
+}
+
+func shuffleFromPb(s *pb.ShuffleSettings) *biz.ShuffleSettings {
+	if s == nil {
+		return nil
+	}
+	return &biz.ShuffleSettings{Questions: s.GetQuestions(), Answers: s.GetAnswers()}
+}
+
+func adaptiveFromPb(a *pb.AdaptiveSettings) *biz.AdaptiveSettings {
+	if a == nil {
+		return nil
+	}
+	settings := &biz.AdaptiveSettings{Length: a.GetLength()}
+	if a.StartDifficulty != nil {
+		difficulty := uint64(a.GetStartDifficulty())
+		settings.StartDifficulty = &difficulty
+	}
+	return settings
+}
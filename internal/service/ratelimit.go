@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+	"quiz/internal/biz"
+)
+
+// RateLimit counts every request against its caller and route, rejecting
+// those over the limit with a Retry-After header. The caller is put into the
+// context for the limits usecases enforce themselves, whose rejections get
+// the header too. Health checks are let through unlimited.
+func RateLimit(l *biz.RateLimiter) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || strings.HasPrefix(tr.Operation(), healthOperationPrefix) {
+				return handler(ctx, req)
+			}
+			caller := biz.Caller{
+				UserID: userIDFromContext(ctx),
+				IP:     clientIP(tr.RequestHeader(), l.ClientIPHeader(), peerAddr(ctx)),
+			}
+			ctx = biz.WithCaller(ctx, caller)
+			if err := l.AllowRoute(ctx, caller, tr.Operation()); err != nil {
+				tr.ReplyHeader().Set("Retry-After", errors.FromError(err).Metadata["retry_after"])
+				return nil, err
+			}
+			reply, err := handler(ctx, req)
+			if e := errors.FromError(err); e != nil && e.Metadata["retry_after"] != "" {
+				tr.ReplyHeader().Set("Retry-After", e.Metadata["retry_after"])
+			}
+			return reply, err
+		}
+	}
+}
+
+// RateLimitHandler does what RateLimit does for a plain HTTP handler, which
+// the middleware chain does not run for. operation is the path it is
+// served under.
+func RateLimitHandler(l *biz.RateLimiter, operation string, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		caller := biz.Caller{
+			UserID: r.Header.Get(userIDHeader),
+			IP:     clientIP(r.Header, l.ClientIPHeader(), r.RemoteAddr),
+		}
+		ctx := biz.WithCaller(r.Context(), caller)
+		if err := l.AllowRoute(ctx, caller, operation); err != nil {
+			w.Header().Set("Retry-After", errors.FromError(err).Metadata["retry_after"])
+			writeError(w, err)
+			return
+		}
+		h(w, r.WithContext(ctx))
+	}
+}
+
+// clientIP returns the address the request came from. Behind a proxy that is
+// the last entry of ipHeader, the one the proxy appended itself; the ones
+// before it are whatever the client sent. Otherwise it is the host of addr,
+// the address of the connection.
+func clientIP(header interface{ Values(string) []string }, ipHeader string, addr string) string {
+	if ipHeader != "" {
+		if values := header.Values(ipHeader); len(values) > 0 {
+			entries := strings.Split(values[len(values)-1], ",")
+			if ip := strings.TrimSpace(entries[len(entries)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// peerAddr returns the address of the connection an RPC came in on.
+func peerAddr(ctx context.Context) string {
+	if r, ok := khttp.RequestFromServerContext(ctx); ok {
+		return r.RemoteAddr
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		return p.Addr.String()
+	}
+	return ""
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type ResultsService struct {
+	pb.UnimplementedResultsServer
+	uc     *biz.ResultsUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewResultsService(uc *biz.ResultsUsecase, logger log.Logger, tracer trace.Tracer) *ResultsService {
+	return &ResultsService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *ResultsService) ListUserAttempts(ctx context.Context, req *pb.ListUserAttemptsRequest) (*pb.ListUserAttemptsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ResultsService.ListUserAttempts")
+	defer span.End()
+
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	attempts, err := s.uc.ListUserAttempts(ctx, req.GetUserId(), userIDFromContext(ctx), req.GetQuizId(), pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := make([]*pb.AttemptSummary, 0, len(attempts))
+	for _, a := range attempts {
+		res = append(res, biz.AttemptSummaryToPb(a))
+	}
+	return &pb.ListUserAttemptsResponse{
+		Attempts: res,
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}, nil
+}
+func (s *ResultsService) GetUserStats(ctx context.Context, req *pb.GetUserStatsRequest) (*pb.GetUserStatsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ResultsService.GetUserStats")
+	defer span.End()
+
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	stats, err := s.uc.GetUserStats(ctx, req.GetUserId(), userIDFromContext(ctx), pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.GetUserStatsResponse{
+		Stats: quizStatsToPb(stats),
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}, nil
+}
+func (s *ResultsService) GetQuizResults(ctx context.Context, req *pb.GetQuizResultsRequest) (*pb.GetQuizResultsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ResultsService.GetQuizResults")
+	defer span.End()
+
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	stats, err := s.uc.GetQuizResults(ctx, req.GetQuizId(), userIDFromContext(ctx), pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.GetQuizResultsResponse{
+		Stats: quizStatsToPb(stats),
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}, nil
+}
+func (s *ResultsService) GetQuizAnalytics(ctx context.Context, req *pb.GetQuizAnalyticsRequest) (*pb.GetQuizAnalyticsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.ResultsService.GetQuizAnalytics")
+	defer span.End()
+
+	analytics, err := s.uc.GetQuizAnalytics(ctx, req.GetQuizId(), userIDFromContext(ctx), req.GetMinResponses())
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return biz.QuizAnalyticsToPb(analytics), nil
+}
+
+func quizStatsToPb(stats []*biz.QuizStats) []*pb.QuizStats {
+	res := make([]*pb.QuizStats, 0, len(stats))
+	for _, s := range stats {
+		res = append(res, biz.QuizStatsToPb(s))
+	}
+	return res
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+const (
+	// RoomSocketPath is where hosts and players of a room connect.
+	RoomSocketPath = "/rooms/{pin}/ws"
+
+	roomPingInterval = 30 * time.Second
+	roomWriteTimeout = 10 * time.Second
+	maxRoomMessage   = 4 << 10
+)
+
+type RoomsService struct {
+	pb.UnimplementedRoomsServer
+	uc       *biz.RoomsUsecase
+	upgrader websocket.Upgrader
+	log      *log.Helper
+	tracer   trace.Tracer
+}
+
+func NewRoomsService(uc *biz.RoomsUsecase, logger log.Logger, tracer trace.Tracer) *RoomsService {
+	return &RoomsService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *RoomsService) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest) (*pb.CreateRoomResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.RoomsService.CreateRoom")
+	defer span.End()
+
+	countdown := time.Duration(req.GetCountdownSeconds()) * time.Second
+	room, err := s.uc.CreateRoom(ctx, req.GetQuizId(), userIDFromContext(ctx), countdown)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.CreateRoomResponse{Room: &pb.Room{
+		Pin:              room.PIN,
+		QuizId:           room.QuizID,
+		HostId:           room.HostID,
+		CountdownSeconds: uint32(room.Countdown / time.Second),
+		Questions:        uint32(room.Questions),
+		WsPath:           strings.Replace(RoomSocketPath, "{pin}", room.PIN, 1),
+	}}, nil
+}
+
+// ServeRoom upgrades to a WebSocket carrying the events of a room to the
+// client and the client's actions, as JSON biz.RoomAction, to the room. The
+// caller is identified the same way as for RPCs; players pick a display name
+// with the name query parameter.
+func (s *RoomsService) ServeRoom(w http.ResponseWriter, r *http.Request) {
+	pin := mux.Vars(r)["pin"]
+	userID := r.Header.Get(userIDHeader)
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	sub, err := s.uc.Subscribe(ctx, pin, userID)
+	if err == nil {
+		err = s.uc.Join(ctx, pin, userID, r.URL.Query().Get("name"))
+	}
+	if err != nil {
+		s.log.Warn(err)
+		writeError(w, err)
+		return
+	}
+	defer func() {
+		_ = s.uc.Act(context.Background(), pin, &biz.RoomAction{Type: biz.RoomActionLeave, UserID: userID})
+	}()
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has already replied
+		s.log.Warn(err)
+		return
+	}
+	defer conn.Close()
+
+	go func() {
+		defer cancel()
+		conn.SetReadLimit(maxRoomMessage)
+		_ = conn.SetReadDeadline(time.Now().Add(2 * roomPingInterval))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(2 * roomPingInterval))
+		})
+		for {
+			var action biz.RoomAction
+			if err := conn.ReadJSON(&action); err != nil {
+				return
+			}
+			// clients only ever act for themselves
+			action.UserID = userID
+			if err := s.uc.Act(ctx, pin, &action); err != nil {
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(roomPingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-sub.C:
+			if !ok {
+				code, reason := websocket.CloseNormalClosure, "room closed"
+				if err := sub.Err(); err != nil {
+					code, reason = websocket.ClosePolicyViolation, errors.FromError(err).Message
+				}
+				_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(roomWriteTimeout))
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(roomWriteTimeout))
+			if err := conn.WriteJSON(e); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(roomWriteTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
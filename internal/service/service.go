@@ -1,6 +1,37 @@
 package service
 
-import "github.com/google/wire"
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/google/wire"
+)
 
 // ProviderSet is service providers.
-var ServiceProviderSet = wire.NewSet(NewQuizzesService, NewQuestionsService)
+var ServiceProviderSet = wire.NewSet(NewQuizzesService, NewQuestionsService, NewProductsService, NewEntitlementsService, NewAttemptsService, NewResultsService, NewLeaderboardsService, NewRoomsService, NewLiveQuizService, NewWebhooksService, NewAuditLogService, NewCollaboratorsService, NewGroupsService, NewAssignmentsService, NewTranslationsService, NewMediaService, NewHealthService)
+
+// userIDHeader carries the authenticated caller, set by the gateway in front of the service.
+const userIDHeader = "X-User-Id"
+
+// attemptTokenHeader carries the token of an anonymous attempt.
+const attemptTokenHeader = "X-Attempt-Token"
+
+// localeFromContext returns the locales the caller prefers: requested when set,
+// otherwise the Accept-Language header.
+func localeFromContext(ctx context.Context, requested *string) string {
+	if requested != nil && *requested != "" {
+		return *requested
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.RequestHeader().Get("Accept-Language")
+	}
+	return ""
+}
+
+// userIDFromContext returns the caller's user ID, or an empty string for anonymous requests.
+func userIDFromContext(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.RequestHeader().Get(userIDHeader)
+	}
+	return ""
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type TranslationsService struct {
+	pb.UnimplementedTranslationsServer
+	uc     *biz.TranslationsUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewTranslationsService(uc *biz.TranslationsUsecase, logger log.Logger, tracer trace.Tracer) *TranslationsService {
+	return &TranslationsService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *TranslationsService) TranslateQuiz(ctx context.Context, req *pb.TranslateQuizRequest) (*pb.TranslateQuizResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.TranslationsService.TranslateQuiz")
+	defer span.End()
+
+	res, err := s.uc.TranslateQuiz(ctx, req.GetQuizId(), req.GetLocale(), req.GetTitle(), req.GetDescription())
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.TranslateQuizResponse{Quiz: biz.QuizToPb(res)}, nil
+}
+
+func (s *TranslationsService) TranslateQuestion(ctx context.Context, req *pb.TranslateQuestionRequest) (*pb.TranslateQuestionResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.TranslationsService.TranslateQuestion")
+	defer span.End()
+
+	answers := make([]biz.AnswerTranslation, 0, len(req.GetAnswers()))
+	for _, a := range req.GetAnswers() {
+		answers = append(answers, biz.AnswerTranslation{
+			AnswerID:    a.GetAnswerId(),
+			Text:        a.GetText(),
+			Explanation: a.GetExplanation(),
+		})
+	}
+	res, err := s.uc.TranslateQuestion(ctx, req.GetQuestionId(), req.GetLocale(), req.GetQuestion(), req.GetHint(), answers)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.TranslateQuestionResponse{Question: biz.QuestionToPb(res)}, nil
+}
+
+func (s *TranslationsService) RemoveLocale(ctx context.Context, req *pb.RemoveLocaleRequest) (*pb.RemoveLocaleResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.TranslationsService.RemoveLocale")
+	defer span.End()
+
+	res, err := s.uc.RemoveLocale(ctx, req.GetQuizId(), req.GetLocale())
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.RemoveLocaleResponse{Quiz: biz.QuizToPb(res)}, nil
+}
+
+func (s *TranslationsService) ListMissingTranslations(ctx context.Context, req *pb.ListMissingTranslationsRequest) (*pb.ListMissingTranslationsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.TranslationsService.ListMissingTranslations")
+	defer span.End()
+
+	missing, err := s.uc.ListMissingTranslations(ctx, req.GetQuizId(), req.GetLocale())
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := &pb.ListMissingTranslationsResponse{Missing: make([]*pb.MissingTranslation, 0, len(missing))}
+	for _, m := range missing {
+		res.Missing = append(res.Missing, &pb.MissingTranslation{
+			Locale:     m.Locale,
+			QuestionId: m.QuestionID,
+			AnswerId:   m.AnswerID,
+			Field:      m.Field,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
+	"quiz/internal/biz"
+)
+
+// validatorReason is the reason the kratos validate middleware gives its
+// errors.
+const validatorReason = "VALIDATOR"
+
+type fieldViolation interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// Validator rejects requests breaking the rules declared in the protos with
+// the kratos validate middleware, reporting the invalid field in the error
+// metadata like the usecases do.
+func Validator() middleware.Middleware {
+	return middleware.Chain(fieldErrors(), validate.Validator())
+}
+
+// fieldErrors turns the errors of the validate middleware into
+// VALIDATION_FAILED errors.
+func fieldErrors() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			reply, err := handler(ctx, req)
+			if e := errors.FromError(err); e != nil && e.Reason == validatorReason {
+				fields := make(map[string]string)
+				collectViolation(e.Unwrap(), "", fields)
+				return nil, biz.FieldErrors(fields).WithCause(err)
+			}
+			return reply, err
+		}
+	}
+}
+
+// collectViolation puts err into fields, naming nested fields by their path,
+// such as answers[1].text.
+func collectViolation(err error, prefix string, fields map[string]string) {
+	v, ok := err.(fieldViolation)
+	if !ok {
+		fields[strings.TrimSuffix(prefix, ".")] = err.Error()
+		return
+	}
+	name := prefix + snakeCase(v.Field())
+	if _, nested := v.Cause().(fieldViolation); nested {
+		collectViolation(v.Cause(), name+".", fields)
+		return
+	}
+	fields[name] = v.Reason()
+}
+
+// snakeCase turns the Go field names protoc-gen-validate reports back into
+// their proto spelling, so QuestionId becomes question_id.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && name[i-1] != '[' {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
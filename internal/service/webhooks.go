@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+	"quiz/internal/biz"
+
+	pb "quiz/api/quizzes/v1"
+)
+
+type WebhooksService struct {
+	pb.UnimplementedWebhooksServer
+	uc     *biz.WebhooksUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewWebhooksService(uc *biz.WebhooksUsecase, logger log.Logger, tracer trace.Tracer) *WebhooksService {
+	return &WebhooksService{
+		uc:     uc,
+		log:    log.NewHelper(logger),
+		tracer: tracer,
+	}
+}
+
+func (s *WebhooksService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.WebhooksService.CreateWebhook")
+	defer span.End()
+
+	w, err := s.uc.CreateWebhook(ctx, userIDFromContext(ctx), req.GetUrl(), req.GetEvents(), req.GetSecret())
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	webhook := biz.WebhookToPb(w)
+	webhook.Secret = &w.Secret
+	return &pb.CreateWebhookResponse{Webhook: webhook}, nil
+}
+
+func (s *WebhooksService) ListWebhooks(ctx context.Context, _ *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.WebhooksService.ListWebhooks")
+	defer span.End()
+
+	webhooks, err := s.uc.ListWebhooks(ctx, userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := &pb.ListWebhooksResponse{Webhooks: make([]*pb.Webhook, 0, len(webhooks))}
+	for _, w := range webhooks {
+		res.Webhooks = append(res.Webhooks, biz.WebhookToPb(w))
+	}
+	return res, nil
+}
+
+func (s *WebhooksService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.WebhooksService.DeleteWebhook")
+	defer span.End()
+
+	if err := s.uc.DeleteWebhook(ctx, req.GetId(), userIDFromContext(ctx)); err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.DeleteWebhookResponse{Id: req.GetId()}, nil
+}
+
+func (s *WebhooksService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.WebhooksService.ListWebhookDeliveries")
+	defer span.End()
+
+	var status *biz.WebhookStatus
+	if req.Status != nil {
+		st := biz.WebhookStatus(req.GetStatus())
+		status = &st
+	}
+	pagination := biz.PaginationOrDefault(&biz.Pagination{
+		Page: req.GetPagination().GetPage(),
+		Size: req.GetPagination().GetPageSize(),
+	})
+	deliveries, err := s.uc.ListDeliveries(ctx, req.GetWebhookId(), userIDFromContext(ctx), status, pagination)
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	res := &pb.ListWebhookDeliveriesResponse{
+		Deliveries: make([]*pb.WebhookDelivery, 0, len(deliveries)),
+		Pagination: &pb.Pagination{
+			Page:     &pagination.Page,
+			PageSize: &pagination.Size,
+		},
+	}
+	for _, d := range deliveries {
+		res.Deliveries = append(res.Deliveries, biz.WebhookDeliveryToPb(d))
+	}
+	return res, nil
+}
+
+func (s *WebhooksService) ReplayWebhookDelivery(ctx context.Context, req *pb.ReplayWebhookDeliveryRequest) (*pb.ReplayWebhookDeliveryResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "service.WebhooksService.ReplayWebhookDelivery")
+	defer span.End()
+
+	d, err := s.uc.ReplayDelivery(ctx, req.GetId(), userIDFromContext(ctx))
+	if err != nil {
+		s.log.Warn(err)
+		return nil, err
+	}
+	return &pb.ReplayWebhookDeliveryResponse{Delivery: biz.WebhookDeliveryToPb(d)}, nil
+}